@@ -2,11 +2,19 @@ package cyclonedx
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/bom-squad/protobom/pkg/formats"
 )
 
+// supportedVersions and supportedEncodings list the values accepted by
+// ParseVersion and ParseEncoding, to report them in the errors.
+var (
+	supportedVersions  = []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"}
+	supportedEncodings = []string{formats.JSON, formats.XML}
+)
+
 func ParseVersion(version string) (cyclonedx.SpecVersion, error) {
 	var specVersion cyclonedx.SpecVersion
 	switch version {
@@ -22,8 +30,12 @@ func ParseVersion(version string) (cyclonedx.SpecVersion, error) {
 		specVersion = cyclonedx.SpecVersion1_4
 	case "1.5":
 		specVersion = cyclonedx.SpecVersion1_5
+	case "1.6":
+		specVersion = cyclonedx.SpecVersion1_6
 	default:
-		return specVersion, fmt.Errorf("unsupported CDX version %s", version)
+		return specVersion, fmt.Errorf(
+			"unsupported CDX version %q; supported: %s", version, strings.Join(supportedVersions, ", "),
+		)
 	}
 
 	return specVersion, nil
@@ -37,8 +49,139 @@ func ParseEncoding(encoding string) (cyclonedx.BOMFileFormat, error) {
 	case formats.JSON:
 		format = cyclonedx.BOMFileFormatJSON
 	default:
-		return format, fmt.Errorf("unsupported CDX encoding %s", encoding)
+		return format, fmt.Errorf(
+			"unsupported CDX encoding %q; supported: %s", encoding, strings.Join(supportedEncodings, ", "),
+		)
 	}
 
 	return format, nil
 }
+
+const (
+	// PropertyPrefix is the namespace of the component properties protobom
+	// uses to preserve data that has no native field in CycloneDX.
+	PropertyPrefix = "protobom:"
+
+	// PropertyIdentifierPrefix namespaces the software identifiers that
+	// CycloneDX cannot express natively. The identifier type follows the
+	// prefix in lowercase, eg "protobom:identifier:swid".
+	PropertyIdentifierPrefix = PropertyPrefix + "identifier:"
+
+	// PropertyBuildPrefix namespaces the node properties carrying build
+	// metadata, eg "protobom:build:compiler" or "protobom:build:flags".
+	// Unlike the rest of the protobom namespace, these are node data and
+	// are written and read back as any other node property.
+	PropertyBuildPrefix = PropertyPrefix + "build:"
+
+	// PropertyDocumentTypeOther records in the metadata properties the
+	// names of the lifecycles written from protobom document types of type
+	// OTHER, to tell them apart from the lifecycles without a type.
+	PropertyDocumentTypeOther = PropertyPrefix + "document-type:other"
+
+	// PropertyComponentsSHA256 records the SHA-256 digest of the document
+	// components in the metadata properties.
+	PropertyComponentsSHA256 = PropertyPrefix + "components:sha256"
+
+	// PropertyEdgePrefix namespaces the component properties recording the
+	// relationships CycloneDX cannot express. The protobom edge type follows
+	// the prefix, eg "protobom:edge:buildTool", and the value is the bom-ref
+	// of the related component.
+	PropertyEdgePrefix = PropertyPrefix + "edge:"
+
+	// ExtRefTypeCommentPrefix starts the comment of the external references
+	// written with type other because their protobom type has no CycloneDX
+	// equivalent. The protobom type follows in lowercase, eg
+	// "protobom:type:funding", then the original comment after a space.
+	ExtRefTypeCommentPrefix = PropertyPrefix + "type:"
+
+	// PropertyGroup marks the synthetic components used to group the
+	// components of a type. Its value is the type of the grouped components.
+	PropertyGroup = PropertyPrefix + "group"
+
+	// PropertySupplier records the suppliers of a component after the first
+	// one, which is written to the supplier field. The value is the supplier
+	// encoded as a JSON CycloneDX organizational entity.
+	PropertySupplier = PropertyPrefix + "supplier"
+
+	// PropertyValidUntil records the date until which a component (eg a
+	// certificate or a time limited license) is valid, as CycloneDX has no
+	// field for it. The value is an RFC 3339 timestamp in UTC.
+	PropertyValidUntil = PropertyPrefix + "valid-until"
+
+	// PropertySourceSHA256 records the SHA-256 digest of the source
+	// document in the metadata properties.
+	PropertySourceSHA256 = PropertyPrefix + "source:sha256"
+
+	// PropertyDocumentVersion records in the metadata properties the
+	// protobom document version when it is not a number, as the CycloneDX
+	// version is an integer (eg "1.0.0-rc1").
+	PropertyDocumentVersion = PropertyPrefix + "document:version"
+)
+
+// RebaseProperties moves the document properties in the from namespace to
+// the to namespace: the metadata and component properties and the type
+// comments of the external references. Serializers use it to write the
+// protobom namespace under a custom prefix and unserializers to read it back.
+func RebaseProperties(bom *cyclonedx.BOM, from, to string) {
+	if bom == nil {
+		return
+	}
+	RebaseMetadataProperties(bom.Metadata, from, to)
+	if bom.Components != nil {
+		for i := range *bom.Components {
+			RebaseComponentProperties(&(*bom.Components)[i], from, to)
+		}
+	}
+	RebaseExternalReferences(bom.ExternalReferences, from, to)
+}
+
+// RebaseMetadataProperties moves the properties of the metadata and its
+// component from the from namespace to the to namespace.
+func RebaseMetadataProperties(m *cyclonedx.Metadata, from, to string) {
+	if m == nil || from == to {
+		return
+	}
+	rebasePropertyNames(m.Properties, from, to)
+	RebaseComponentProperties(m.Component, from, to)
+}
+
+// RebaseComponentProperties moves the properties of a component and its
+// subcomponents from the from namespace to the to namespace.
+func RebaseComponentProperties(c *cyclonedx.Component, from, to string) {
+	if c == nil || from == to {
+		return
+	}
+	rebasePropertyNames(c.Properties, from, to)
+	RebaseExternalReferences(c.ExternalReferences, from, to)
+	if c.Components != nil {
+		for i := range *c.Components {
+			RebaseComponentProperties(&(*c.Components)[i], from, to)
+		}
+	}
+}
+
+// RebaseExternalReferences moves the type comments of the external
+// references (see ExtRefTypeCommentPrefix) from the from namespace to the
+// to namespace. Other comments are not modified.
+func RebaseExternalReferences(refs *[]cyclonedx.ExternalReference, from, to string) {
+	if refs == nil || from == to {
+		return
+	}
+	typePrefix := strings.TrimPrefix(ExtRefTypeCommentPrefix, PropertyPrefix)
+	for i := range *refs {
+		if rest, ok := strings.CutPrefix((*refs)[i].Comment, from+typePrefix); ok {
+			(*refs)[i].Comment = to + typePrefix + rest
+		}
+	}
+}
+
+func rebasePropertyNames(props *[]cyclonedx.Property, from, to string) {
+	if props == nil {
+		return
+	}
+	for i := range *props {
+		if rest, ok := strings.CutPrefix((*props)[i].Name, from); ok {
+			(*props)[i].Name = to + rest
+		}
+	}
+}
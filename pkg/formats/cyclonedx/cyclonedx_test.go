@@ -0,0 +1,26 @@
+package cyclonedx
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("1.5")
+	require.NoError(t, err)
+	require.Equal(t, cyclonedx.SpecVersion1_5, v)
+
+	_, err = ParseVersion("x")
+	require.EqualError(t, err, `unsupported CDX version "x"; supported: 1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 1.6`)
+}
+
+func TestParseEncoding(t *testing.T) {
+	f, err := ParseEncoding("xml")
+	require.NoError(t, err)
+	require.Equal(t, cyclonedx.BOMFileFormatXML, f)
+
+	_, err = ParseEncoding("yaml")
+	require.EqualError(t, err, `unsupported CDX encoding "yaml"; supported: json, xml`)
+}
@@ -0,0 +1,186 @@
+package cyclonedx
+
+import (
+	"strings"
+
+	"github.com/CycloneDX/cyclonedx-go"
+)
+
+// Enums holds the enumerated values allowed by a version of the CycloneDX
+// spec. Each field is a set of the valid values.
+type Enums struct {
+	ComponentTypes         map[cyclonedx.ComponentType]struct{}
+	ExternalReferenceTypes map[cyclonedx.ExternalReferenceType]struct{}
+	HashAlgorithms         map[cyclonedx.HashAlgorithm]struct{}
+	Scopes                 map[cyclonedx.Scope]struct{}
+	LifecyclePhases        map[cyclonedx.LifecyclePhase]struct{}
+}
+
+// componentTypesSince maps the component types to the spec version that
+// introduced them.
+var componentTypesSince = map[cyclonedx.ComponentType]cyclonedx.SpecVersion{
+	cyclonedx.ComponentTypeApplication:          cyclonedx.SpecVersion1_0,
+	cyclonedx.ComponentTypeDevice:               cyclonedx.SpecVersion1_0,
+	cyclonedx.ComponentTypeFramework:            cyclonedx.SpecVersion1_0,
+	cyclonedx.ComponentTypeLibrary:              cyclonedx.SpecVersion1_0,
+	cyclonedx.ComponentTypeOS:                   cyclonedx.SpecVersion1_0,
+	cyclonedx.ComponentTypeFile:                 cyclonedx.SpecVersion1_1,
+	cyclonedx.ComponentTypeContainer:            cyclonedx.SpecVersion1_2,
+	cyclonedx.ComponentTypeFirmware:             cyclonedx.SpecVersion1_2,
+	cyclonedx.ComponentTypeData:                 cyclonedx.SpecVersion1_5,
+	cyclonedx.ComponentTypeDeviceDriver:         cyclonedx.SpecVersion1_5,
+	cyclonedx.ComponentTypeMachineLearningModel: cyclonedx.SpecVersion1_5,
+	cyclonedx.ComponentTypePlatform:             cyclonedx.SpecVersion1_5,
+	cyclonedx.ComponentTypeCryptographicAsset:   cyclonedx.SpecVersion1_6,
+}
+
+// externalReferenceTypesSince maps the external reference types to the
+// spec version that introduced them.
+var externalReferenceTypesSince = map[cyclonedx.ExternalReferenceType]cyclonedx.SpecVersion{
+	cyclonedx.ERTypeAdvisories:              cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeBOM:                     cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeBuildMeta:               cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeBuildSystem:             cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeChat:                    cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeDistribution:            cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeDocumentation:           cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeIssueTracker:            cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeLicense:                 cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeMailingList:             cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeOther:                   cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeSocial:                  cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeSupport:                 cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeVCS:                     cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeWebsite:                 cyclonedx.SpecVersion1_1,
+	cyclonedx.ERTypeReleaseNotes:            cyclonedx.SpecVersion1_4,
+	cyclonedx.ERTypeAdversaryModel:          cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeAttestation:             cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeCertificationReport:     cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeCodifiedInfrastructure:  cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeComponentAnalysisReport: cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeConfiguration:           cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeDistributionIntake:      cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeDynamicAnalysisReport:   cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeEvidence:                cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeExploitabilityStatement: cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeFormulation:             cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeLog:                     cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeMaturityReport:          cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeModelCard:               cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypePentestReport:           cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeQualityMetrics:          cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeRiskAssessment:          cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeRuntimeAnalysisReport:   cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeSecurityContact:         cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeStaticAnalysisReport:    cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeThreatModel:             cyclonedx.SpecVersion1_5,
+	cyclonedx.ERTypeVulnerabilityAssertion:  cyclonedx.SpecVersion1_5,
+	"poam":                                  cyclonedx.SpecVersion1_5,
+	"digital-signature":                     cyclonedx.SpecVersion1_6,
+	"electronic-signature":                  cyclonedx.SpecVersion1_6,
+	"rfc-9116":                              cyclonedx.SpecVersion1_6,
+	"source-distribution":                   cyclonedx.SpecVersion1_6,
+}
+
+// hashAlgorithmsSince maps the hash algorithms to the spec version that
+// introduced them.
+var hashAlgorithmsSince = map[cyclonedx.HashAlgorithm]cyclonedx.SpecVersion{
+	cyclonedx.HashAlgoMD5:         cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA1:        cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA256:      cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA384:      cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA512:      cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA3_256:    cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA3_512:    cyclonedx.SpecVersion1_0,
+	cyclonedx.HashAlgoSHA3_384:    cyclonedx.SpecVersion1_2,
+	cyclonedx.HashAlgoBlake2b_256: cyclonedx.SpecVersion1_2,
+	cyclonedx.HashAlgoBlake2b_384: cyclonedx.SpecVersion1_2,
+	cyclonedx.HashAlgoBlake2b_512: cyclonedx.SpecVersion1_2,
+	cyclonedx.HashAlgoBlake3:      cyclonedx.SpecVersion1_2,
+}
+
+// scopesSince maps the component scopes to the spec version that
+// introduced them.
+var scopesSince = map[cyclonedx.Scope]cyclonedx.SpecVersion{
+	cyclonedx.ScopeOptional: cyclonedx.SpecVersion1_0,
+	cyclonedx.ScopeRequired: cyclonedx.SpecVersion1_0,
+	cyclonedx.ScopeExcluded: cyclonedx.SpecVersion1_2,
+}
+
+// lifecyclePhasesSince maps the lifecycle phases to the spec version that
+// introduced them.
+var lifecyclePhasesSince = map[cyclonedx.LifecyclePhase]cyclonedx.SpecVersion{
+	cyclonedx.LifecyclePhaseBuild:        cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhaseDecommission: cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhaseDesign:       cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhaseDiscovery:    cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhaseOperations:   cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhasePostBuild:    cyclonedx.SpecVersion1_5,
+	cyclonedx.LifecyclePhasePreBuild:     cyclonedx.SpecVersion1_5,
+}
+
+// componentTypeAliases maps common spellings of the component types found
+// in the wild to the type they mean. The keys are normalized, see
+// NormalizeComponentType.
+var componentTypeAliases = map[string]cyclonedx.ComponentType{
+	"app":             cyclonedx.ComponentTypeApplication,
+	"executable":      cyclonedx.ComponentTypeApplication,
+	"lib":             cyclonedx.ComponentTypeLibrary,
+	"package":         cyclonedx.ComponentTypeLibrary,
+	"os":              cyclonedx.ComponentTypeOS,
+	"driver":          cyclonedx.ComponentTypeDeviceDriver,
+	"container-image": cyclonedx.ComponentTypeContainer,
+	"image":           cyclonedx.ComponentTypeContainer,
+	"ml-model":        cyclonedx.ComponentTypeMachineLearningModel,
+	"model":           cyclonedx.ComponentTypeMachineLearningModel,
+	"ai-model":        cyclonedx.ComponentTypeMachineLearningModel,
+	"dataset":         cyclonedx.ComponentTypeData,
+	"crypto-asset":    cyclonedx.ComponentTypeCryptographicAsset,
+}
+
+// NormalizeComponentType returns the component type meant by a loosely
+// spelled value: it is matched case-insensitively, with underscores and
+// spaces read as hyphens, and common aliases (eg "ML-Model" or
+// "operating_system") are resolved. Values that cannot be resolved are
+// returned unchanged.
+func NormalizeComponentType(t cyclonedx.ComponentType) cyclonedx.ComponentType {
+	norm := strings.ToLower(strings.TrimSpace(string(t)))
+	norm = strings.NewReplacer("_", "-", " ", "-").Replace(norm)
+	if _, ok := componentTypesSince[cyclonedx.ComponentType(norm)]; ok {
+		return cyclonedx.ComponentType(norm)
+	}
+	if alias, ok := componentTypeAliases[norm]; ok {
+		return alias
+	}
+	return t
+}
+
+// ValidExternalReferenceType returns true if the external reference type is
+// allowed by a version of the CycloneDX spec.
+func ValidExternalReferenceType(t cyclonedx.ExternalReferenceType, version cyclonedx.SpecVersion) bool {
+	since, ok := externalReferenceTypesSince[t]
+	return ok && since <= version
+}
+
+// ValidEnums returns the enumerated values allowed by a version of the
+// CycloneDX spec.
+func ValidEnums(version cyclonedx.SpecVersion) Enums {
+	return Enums{
+		ComponentTypes:         validSince(componentTypesSince, version),
+		ExternalReferenceTypes: validSince(externalReferenceTypesSince, version),
+		HashAlgorithms:         validSince(hashAlgorithmsSince, version),
+		Scopes:                 validSince(scopesSince, version),
+		LifecyclePhases:        validSince(lifecyclePhasesSince, version),
+	}
+}
+
+// validSince returns the set of values introduced up to version
+func validSince[T comparable](since map[T]cyclonedx.SpecVersion, version cyclonedx.SpecVersion) map[T]struct{} {
+	ret := map[T]struct{}{}
+	for value, v := range since {
+		if v <= version {
+			ret[value] = struct{}{}
+		}
+	}
+	return ret
+}
@@ -65,6 +65,13 @@ func TestSniffReader(t *testing.T) {
 			formatType: "cyclonedx",
 			encoding:   "json",
 		},
+		{
+			filename:   "testdata/minified-1.6.cdx.json",
+			mustError:  false,
+			version:    "1.6",
+			formatType: "cyclonedx",
+			encoding:   "json",
+		},
 		{
 			filename:  "testdata/syft.json",
 			mustError: true,
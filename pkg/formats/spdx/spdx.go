@@ -23,6 +23,8 @@ const (
 	ExtRefTypeCPE22  = "cpe22Type"
 	ExtRefTypeCPE23  = "cpe23Type"
 	ExtRefTypeGitoid = "gitoid"
+	ExtRefTypeSwid   = "swid"
+	ExtRefTypeSwh    = "swh"
 )
 
 // ParseActorString parses an SPDX "actor string", it is a specially formatted
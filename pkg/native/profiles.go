@@ -0,0 +1,54 @@
+package native
+
+import "fmt"
+
+// SerializeProfile is a named preset of serializer options tuned for the
+// expectations of a particular class of SBOM consumers.
+type SerializeProfile string
+
+const (
+	// ProfileDependencyTrack lists protobom as the generator tool, leaves
+	// out the protobom properties, which Dependency-Track ignores, and
+	// makes all the components reachable from the dependencies of a
+	// single subject component, which is where Dependency-Track starts
+	// walking the dependency graph.
+	ProfileDependencyTrack SerializeProfile = "dependency-track"
+
+	// ProfileStrict only writes data that has a native field in the
+	// output format, and fails instead of rewriting invalid strings or
+	// versions.
+	ProfileStrict SerializeProfile = "strict"
+
+	// ProfileLossless preserves as much of the protobom data as possible,
+	// using properties and evidence for data without a native field and
+	// writing all the root elements of the document.
+	ProfileLossless SerializeProfile = "lossless"
+)
+
+// Options returns a new set of serializer options for the profile.
+func (p SerializeProfile) Options() (*SerializeOptions, error) {
+	switch p {
+	case ProfileDependencyTrack:
+		return &SerializeOptions{
+			AddGeneratorTool:  true,
+			DisableProperties: true,
+			MultipleRoots:     MultipleRootsAggregate,
+			RootDependencies:  true,
+		}, nil
+	case ProfileStrict:
+		return &SerializeOptions{
+			DisableProperties: true,
+			StrictUTF8:        true,
+			StrictVersion:     true,
+		}, nil
+	case ProfileLossless:
+		return &SerializeOptions{
+			CPE22Property:   true,
+			ExtraSuppliers:  ExtraSuppliersProperties,
+			LicenseEvidence: true,
+			MultipleRoots:   MultipleRootsComponents,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown serialization profile %q", p)
+	}
+}
@@ -0,0 +1,164 @@
+package native_test
+
+import (
+	"strings"
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/native/serializers"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+// profileTestDocument returns a document with the data the profiles write
+// differently: two root elements, a CPE 2.2 identifier next to a CPE 2.3
+// one, two suppliers and a license.
+func profileTestDocument() *sbom.Document {
+	doc := sbom.NewDocument()
+	doc.Metadata.Version = "1"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "cli", Name: "cli", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:acme:lib:1.0.0:*:*:*:*:*:*:*",
+			int32(sbom.SoftwareIdentifierType_CPE22): "cpe:/a:acme:lib:1.0.0",
+		},
+		Suppliers: []*sbom.Person{{Name: "Acme"}, {Name: "Mirror"}},
+		Licenses:  []string{"Apache-2.0"},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	return doc
+}
+
+// findComponent returns the component with a bom-ref in a list and its
+// subcomponents.
+func findComponent(comps *[]cdx.Component, ref string) *cdx.Component {
+	if comps == nil {
+		return nil
+	}
+	for i := range *comps {
+		if (*comps)[i].BOMRef == ref {
+			return &(*comps)[i]
+		}
+		if c := findComponent((*comps)[i].Components, ref); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// protobomProperties returns the names of the protobom properties of a
+// component.
+func protobomProperties(c *cdx.Component) []string {
+	names := []string{}
+	if c.Properties == nil {
+		return names
+	}
+	for _, p := range *c.Properties {
+		if strings.HasPrefix(p.Name, cdxformats.PropertyPrefix) {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+func TestSerializeProfileOptions(t *testing.T) {
+	for _, tc := range []struct {
+		profile native.SerializeProfile
+		doc     func() *sbom.Document
+		mustErr bool
+		check   func(*testing.T, *cdx.BOM)
+	}{
+		{
+			profile: native.ProfileDependencyTrack,
+			doc:     profileTestDocument,
+			check: func(t *testing.T, bom *cdx.BOM) {
+				require.NotNil(t, bom.Metadata.Tools)
+				require.NotEmpty(t, *bom.Metadata.Tools.Components)
+
+				// Both roots hang from a single subject, which lists them
+				// as its dependencies
+				root := bom.Metadata.Component
+				require.True(t, strings.HasPrefix(root.BOMRef, "protobom-aggregate-root"))
+				var rootDeps []string
+				for _, d := range *bom.Dependencies {
+					if d.Ref == root.BOMRef && d.Dependencies != nil {
+						rootDeps = *d.Dependencies
+					}
+				}
+				require.ElementsMatch(t, []string{"app", "cli"}, rootDeps)
+
+				lib := findComponent(root.Components, "lib")
+				require.NotNil(t, lib)
+				require.Empty(t, protobomProperties(lib))
+			},
+		},
+		{
+			profile: native.ProfileStrict,
+			doc: func() *sbom.Document {
+				doc := profileTestDocument()
+				doc.NodeList.RootElements = []string{"app"}
+				return doc
+			},
+			check: func(t *testing.T, bom *cdx.BOM) {
+				require.Nil(t, bom.Metadata.Tools)
+				lib := findComponent(bom.Metadata.Component.Components, "lib")
+				require.NotNil(t, lib)
+				require.Equal(t, "cpe:2.3:a:acme:lib:1.0.0:*:*:*:*:*:*:*", lib.CPE)
+				require.Empty(t, protobomProperties(lib))
+				require.Nil(t, lib.Evidence)
+			},
+		},
+		{
+			// Versions that are not a number are not rewritten
+			profile: native.ProfileStrict,
+			doc: func() *sbom.Document {
+				doc := profileTestDocument()
+				doc.NodeList.RootElements = []string{"app"}
+				doc.Metadata.Version = "1.0.0-rc1"
+				return doc
+			},
+			mustErr: true,
+		},
+		{
+			profile: native.ProfileLossless,
+			doc:     profileTestDocument,
+			check: func(t *testing.T, bom *cdx.BOM) {
+				require.Nil(t, bom.Metadata.Tools)
+
+				// The second root is written as a top level component
+				require.Equal(t, "app", bom.Metadata.Component.BOMRef)
+				require.NotNil(t, findComponent(bom.Components, "cli"))
+
+				lib := findComponent(bom.Metadata.Component.Components, "lib")
+				require.NotNil(t, lib)
+				require.ElementsMatch(t, []string{
+					cdxformats.PropertyIdentifierPrefix + "cpe22",
+					cdxformats.PropertySupplier,
+				}, protobomProperties(lib))
+				require.NotNil(t, lib.Evidence)
+				require.Len(t, *lib.Evidence.Licenses, 1)
+			},
+		},
+	} {
+		t.Run(string(tc.profile), func(t *testing.T) {
+			opts, err := tc.profile.Options()
+			require.NoError(t, err)
+
+			out, err := serializers.NewCDX("1.5", "json").Serialize(tc.doc(), opts, nil)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, out.(*cdx.BOM))
+		})
+	}
+
+	_, err := native.SerializeProfile("grype").Options()
+	require.Error(t, err)
+}
@@ -3,8 +3,12 @@ package native
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"time"
 
+	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/bom-squad/protobom/pkg/sbom"
 )
 
@@ -14,8 +18,396 @@ type Serializer interface {
 	Render(interface{}, io.Writer, *RenderOptions, interface{}) error
 }
 
+// ContextSerializer is implemented by the serializers that take a context,
+// to stop serializing large documents when the caller cancels it.
+type ContextSerializer interface {
+	Serializer
+	SerializeContext(context.Context, *sbom.Document, *SerializeOptions, interface{}) (interface{}, error)
+}
+
 type RenderOptions struct {
+	// Indent is the number of spaces each level is indented with when
+	// rendering pretty output. JSON defaults to two spaces when it is not
+	// set, CycloneDX XML is always indented with two spaces.
 	Indent int
+
+	// Compact renders the document without indentation or line breaks.
+	// Documents are rendered pretty by default, and when the options are
+	// nil.
+	Compact bool
+}
+
+// BOMRefStrategy selects how the serializers generate the identifiers
+// of the elements in the output document.
+type BOMRefStrategy string
+
+const (
+	// BOMRefNodeID uses the protobom node IDs as identifiers.
+	BOMRefNodeID BOMRefStrategy = ""
+
+	// BOMRefPurlHash derives the identifiers from the SHA-256 digest of the
+	// node purl and its primary hash. The identifiers stay the same across
+	// scans of the same software, even when the node IDs change.
+	BOMRefPurlHash BOMRefStrategy = "purl-hash"
+)
+
+// ComponentDetail sets how much data of each component the serializers
+// write to the output document.
+type ComponentDetail string
+
+const (
+	// ComponentDetailFull writes all the data of the components.
+	ComponentDetailFull ComponentDetail = ""
+
+	// ComponentDetailStandard writes the identity of the components along
+	// with their type, scope, hashes, licenses, supplier and manufacturer.
+	// Free text fields, properties, external references and release notes
+	// are dropped.
+	ComponentDetailStandard ComponentDetail = "standard"
+
+	// ComponentDetailLite only writes the identity of the components: their
+	// identifier, name, version and package URL.
+	ComponentDetailLite ComponentDetail = "lite"
+)
+
+// MultipleRoots selects how the serializers write documents with more than
+// one root element to formats that only support one, like CycloneDX.
+type MultipleRoots string
+
+const (
+	// MultipleRootsError fails to serialize documents with more than
+	// one root element.
+	MultipleRootsError MultipleRoots = ""
+
+	// MultipleRootsComponents writes the first root element as the subject
+	// of the document and the rest as top level components.
+	MultipleRootsComponents MultipleRoots = "components"
+
+	// MultipleRootsAggregate writes a synthetic component as the subject
+	// of the document, which contains all the root elements.
+	MultipleRootsAggregate MultipleRoots = "aggregate"
+)
+
+// ExtraSuppliers selects how the serializers write the suppliers of a node
+// to formats that only support one per component, like CycloneDX.
+type ExtraSuppliers string
+
+const (
+	// ExtraSuppliersDrop only writes the first supplier of each node.
+	ExtraSuppliersDrop ExtraSuppliers = ""
+
+	// ExtraSuppliersProperties writes the first supplier of each node to
+	// the native field and the rest as protobom namespaced properties.
+	ExtraSuppliersProperties ExtraSuppliers = "properties"
+)
+
+// DuplicateIDs selects how the serializers handle documents with more than
+// one node with the same ID, which would be written with the same
+// identifier in the output.
+type DuplicateIDs string
+
+const (
+	// DuplicateIDsError fails to serialize documents with duplicate node IDs.
+	DuplicateIDsError DuplicateIDs = ""
+
+	// DuplicateIDsMerge merges the nodes sharing an ID into the first one
+	// and logs a warning.
+	DuplicateIDsMerge DuplicateIDs = "merge"
+)
+
+// EdgeRepresentation selects how the serializers write the edges of a type
+// to formats that model relationships as a component tree, like CycloneDX.
+type EdgeRepresentation string
+
+const (
+	// EdgeDefault writes the edges using the default for their type:
+	// contains edges are nested, dependsOn edges are dependencies and
+	// the rest are written as properties.
+	EdgeDefault EdgeRepresentation = ""
+
+	// EdgeNested nests the target components under the source component.
+	EdgeNested EdgeRepresentation = "nested"
+
+	// EdgeDependency lists the targets as dependencies of the source.
+	EdgeDependency EdgeRepresentation = "dependency"
+
+	// EdgeProperty records the edges as protobom namespaced properties of
+	// the source component, one for each target.
+	EdgeProperty EdgeRepresentation = "property"
+
+	// EdgeDropped leaves the edges out of the output.
+	EdgeDropped EdgeRepresentation = "dropped"
+)
+
+// Degradation records data of the document that could not be written to
+// the output format, or was changed to fit it.
+type Degradation struct {
+	// NodeID is the ID of the node the data belongs to. It is empty when
+	// the data belongs to the document.
+	NodeID string
+
+	// Field names the data that was lost, eg "cpe" or "suppliers".
+	Field string
+
+	// Message describes what happened to the data.
+	Message string
+}
+
+func (d Degradation) String() string {
+	if d.NodeID == "" {
+		return "document: " + d.Message
+	}
+	return fmt.Sprintf("node %q: %s", d.NodeID, d.Message)
 }
 
-type SerializeOptions struct{}
+type SerializeOptions struct {
+	// AddGeneratorTool adds protobom and its version to the list
+	// of tools that generated the document.
+	AddGeneratorTool bool
+
+	// BOMRefStrategy controls how element identifiers are generated.
+	BOMRefStrategy BOMRefStrategy
+
+	// ComponentDetail controls how much per-component data is written.
+	ComponentDetail ComponentDetail
+
+	// ComponentsSHA256 records a SHA-256 digest of the serialized components
+	// in the output metadata. It is a lightweight integrity anchor to detect
+	// changes in the component list, not a signature.
+	ComponentsSHA256 bool
+
+	// ContainsDependencies writes the contains relationships as dependencies
+	// too, in addition to nesting the contained components. This lets
+	// consumers that only walk the dependency graph reach all components.
+	ContainsDependencies bool
+
+	// CPE22Property writes the CPE 2.2 identifier of the nodes that also
+	// have a CPE 2.3 one as a protobom namespaced property. CycloneDX has a
+	// single CPE field, which gets the CPE 2.3 identifier.
+	CPE22Property bool
+
+	// DependencyGraphOnly strips the components down to their identifiers,
+	// type and name, leaving only the structure of the graph and the
+	// dependencies in the output.
+	DependencyGraphOnly bool
+
+	// Degradations is filled with the data lost while serializing the
+	// document when set. The entries are appended to the slice, which
+	// can be reused to collect the degradations of several documents.
+	Degradations *[]Degradation
+
+	// DisableProperties turns off writing the protobom namespaced
+	// properties used to preserve data without a native field in
+	// the output format.
+	DisableProperties bool
+
+	// EdgeRepresentations overrides how the edges of each type are written.
+	// Edge types not listed use their default, see EdgeDefault.
+	EdgeRepresentations map[sbom.Edge_Type]EdgeRepresentation
+
+	// ExtraSuppliers controls how the suppliers of a node after the first
+	// one are written to formats that only support one.
+	ExtraSuppliers ExtraSuppliers
+
+	// FlattenDependencies lists the full transitive closure of the
+	// dependsOn relationships as the dependencies of each component.
+	FlattenDependencies bool
+
+	// ForceCopyright writes the copyright of all components. By default it
+	// is omitted for component types that do not carry one, like operating
+	// systems.
+	ForceCopyright bool
+
+	// GroupComponentsByType nests the top level components under synthetic
+	// parents, one for each component type. The groups are marked with a
+	// protobom property and are not part of the dependency graph. This is
+	// intended for human-readable reports.
+	GroupComponentsByType bool
+
+	// LicenseEvidence also writes the copyright and licenses of the nodes
+	// as CycloneDX component evidence, for consumers of license scanning
+	// results.
+	LicenseEvidence bool
+
+	// MaxComponents limits the number of nodes written to the output
+	// document. Serializing a document with more nodes fails, unless
+	// TruncateComponents is set. Zero means no limit.
+	MaxComponents int
+
+	// MultipleRoots controls how documents with more than one root element
+	// are written to formats that only support one.
+	MultipleRoots MultipleRoots
+
+	// DuplicateIDs controls how documents with more than one node with the
+	// same ID are handled.
+	DuplicateIDs DuplicateIDs
+
+	// TruncateComponents makes documents over MaxComponents get serialized
+	// anyway, leaving out the nodes over the limit. The nodes closest to
+	// the root elements are kept.
+	TruncateComponents bool
+
+	// Timestamp is written as the creation date of the output document
+	// when set, instead of the date stored in the document. It is meant to
+	// produce reproducible outputs (eg from SOURCE_DATE_EPOCH).
+	Timestamp *time.Time
+
+	// StrictUTF8 makes serialization fail when a string in the document is
+	// not valid UTF-8. By default, invalid sequences are replaced with the
+	// Unicode replacement character and a warning is logged.
+	StrictUTF8 bool
+
+	// StrictVersion makes serialization fail when the document version is
+	// not a number. By default, the output keeps the default version of
+	// the format and the original version is recorded in a property.
+	StrictVersion bool
+
+	// NodeTypeToComponentType overrides the CycloneDX component type of the
+	// nodes of a type. By default, files are written as file components and
+	// the type of the rest is derived from their primary purpose. Common
+	// spelling variants of the types (eg "OS" or "ml_model") are normalized.
+	NodeTypeToComponentType map[sbom.Node_NodeType]cdx.ComponentType
+
+	// PathRewriter is applied to the local file paths written to the output
+	// document: the locations of the node occurrences and the external
+	// references pointing to a file (absolute paths or file: URLs). It lets
+	// callers redact or relativize paths like /home/user/... before
+	// publishing. Paths are written as is when not set.
+	PathRewriter func(string) string
+
+	// PropertyPrefix replaces the protobom: namespace of the properties
+	// written to preserve data without a native field in the output
+	// format, eg "acme:". Node properties already in the namespace are
+	// dropped as they would be read back as protobom data. The documents
+	// must be read with the same prefix to restore the data. The protobom:
+	// namespace is used when empty.
+	PropertyPrefix string
+
+	// PruneUnreachable leaves out of the output the nodes that cannot be
+	// reached from the document root elements. The document is not modified.
+	PruneUnreachable bool
+
+	// PURLNormalizer is applied to the package URLs before they are written
+	// to the output document. When not set, package URLs are canonicalized
+	// using sbom.PackageURL.Normalize.
+	PURLNormalizer func(string) string
+
+	// RootDependencies lists the direct children of the root element as
+	// its dependencies too, in the dependency entry of the root component.
+	// Consumers like Dependency-Track walk the dependency graph from there.
+	// ContainsDependencies does the same for all the components.
+	RootDependencies bool
+
+	// SortDependencies sorts the targets listed in the dependencies of each
+	// component. By default they are written in the order of the edges in
+	// the protobom, which depends on the input.
+	SortDependencies bool
+
+	// SourceSHA256 records the SHA-256 digest of the document the protobom
+	// was parsed from (see Metadata.SourceSha256, computed by the
+	// unserializers) in the output metadata, to link the converted document
+	// to its origin. Serialization fails if the digest is not a hex encoded
+	// SHA-256 digest.
+	SourceSHA256 bool
+}
+
+// CollectDegradations appends the degradations found while serializing a
+// document to the Degradations slice, if it is set.
+func (o *SerializeOptions) CollectDegradations(degradations []Degradation) {
+	if o == nil || o.Degradations == nil {
+		return
+	}
+	*o.Degradations = append(*o.Degradations, degradations...)
+}
+
+// RewritePath returns the local path processed by the configured path
+// rewriter or, if there is none, unchanged.
+func (o *SerializeOptions) RewritePath(path string) string {
+	if o != nil && o.PathRewriter != nil {
+		return o.PathRewriter(path)
+	}
+	return path
+}
+
+// NormalizePURL returns the package URL processed by the configured
+// normalizer or, if there is none, in its canonical form.
+func (o *SerializeOptions) NormalizePURL(purl string) string {
+	if o != nil && o.PURLNormalizer != nil {
+		return o.PURLNormalizer(purl)
+	}
+	return string(sbom.PackageURL(purl).Normalize())
+}
+
+// LimitComponents checks the document against the configured maximum number
+// of components. If the document is over the limit, it returns an error or,
+// when truncating, a truncated copy of the document along with the number of
+// nodes left out. Documents within the limit are returned as is.
+func (o *SerializeOptions) LimitComponents(bom *sbom.Document) (*sbom.Document, int, error) {
+	if o == nil || o.MaxComponents <= 0 || len(bom.GetNodeList().GetNodes()) <= o.MaxComponents {
+		return bom, 0, nil
+	}
+	if !o.TruncateComponents {
+		return nil, 0, fmt.Errorf(
+			"document has %d nodes, more than the maximum of %d", len(bom.GetNodeList().GetNodes()), o.MaxComponents,
+		)
+	}
+	bom = &sbom.Document{Metadata: bom.Metadata, NodeList: bom.NodeList.Copy()}
+	return bom, bom.TruncateNodes(o.MaxComponents), nil
+}
+
+// CheckDuplicateIDs looks for nodes sharing an ID in the document. If any
+// are found, it returns an error or, when merging, a copy of the document
+// with the duplicates merged along with the number of nodes removed.
+// Documents without duplicates are returned as is.
+func (o *SerializeOptions) CheckDuplicateIDs(bom *sbom.Document) (*sbom.Document, int, error) {
+	dupes := bom.GetNodeList().DuplicateNodeIDs()
+	if len(dupes) == 0 {
+		return bom, 0, nil
+	}
+
+	strategy := DuplicateIDsError
+	if o != nil {
+		strategy = o.DuplicateIDs
+	}
+	switch strategy {
+	case DuplicateIDsError:
+		return nil, 0, fmt.Errorf("document has %d node IDs shared by more than one node: %q", len(dupes), dupes)
+	case DuplicateIDsMerge:
+		bom = &sbom.Document{Metadata: bom.Metadata, NodeList: bom.NodeList.Copy()}
+		return bom, bom.NodeList.MergeDuplicateIDs(), nil
+	default:
+		return nil, 0, fmt.Errorf("unknown duplicate IDs strategy %q", strategy)
+	}
+}
+
+// EdgeRepresentation returns how the edges of type t are written.
+func (o *SerializeOptions) EdgeRepresentation(t sbom.Edge_Type) EdgeRepresentation {
+	if o != nil {
+		if r, ok := o.EdgeRepresentations[t]; ok && r != EdgeDefault {
+			return r
+		}
+	}
+	switch t {
+	case sbom.Edge_contains:
+		return EdgeNested
+	case sbom.Edge_dependsOn:
+		return EdgeDependency
+	default:
+		return EdgeProperty
+	}
+}
+
+// DocumentDate returns the creation date to write in the output document:
+// the configured timestamp or, if there is none, the date in the document
+// metadata. It returns nil when neither is set.
+func (o *SerializeOptions) DocumentDate(md *sbom.Metadata) *time.Time {
+	if o != nil && o.Timestamp != nil {
+		return o.Timestamp
+	}
+	// Unserializers initialize the date to the zero timestamp
+	if date := md.GetDate(); date != nil && (date.GetSeconds() != 0 || date.GetNanos() != 0) {
+		t := date.AsTime()
+		return &t
+	}
+	return nil
+}
@@ -1,24 +1,40 @@
 package serializers
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
 	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/release-utils/version"
 )
 
 var _ native.Serializer = &CDX{}
 
 const (
 	stateKey state = "cyclonedx_serializer_state"
+
+	// generatorToolName is the name protobom lists itself with in the tools
+	generatorToolName = "protobom"
+
+	// ctxCheckInterval is the number of nodes or edges processed between
+	// checks of the context, to abort large serializations when canceled
+	ctxCheckInterval = 1000
 )
 
 type (
@@ -36,19 +52,101 @@ func NewCDX(version, encoding string) *CDX {
 	}
 }
 
-func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interface{}) (interface{}, error) {
-	// Load the context with the CDX value. We initialize a context here
-	// but we should get it as part of the method to capture cancelations
-	// from the CLI or REST API.
+var _ native.ContextSerializer = &CDX{}
+
+func (s *CDX) Serialize(bom *sbom.Document, opts *native.SerializeOptions, fopts interface{}) (interface{}, error) {
+	return s.SerializeContext(context.Background(), bom, opts, fopts)
+}
+
+// SerializeNodeList writes a bare NodeList to wr as a CycloneDX document,
+// without requiring callers to build a full protobom Document. The node
+// list is wrapped in a document with empty metadata and rendered with ro,
+// pretty when ro is nil.
+func (s *CDX) SerializeNodeList(
+	ctx context.Context, nl *sbom.NodeList, wr io.Writer, opts *native.SerializeOptions, ro *native.RenderOptions,
+) error {
+	if nl == nil {
+		return errors.New("node list is nil")
+	}
+
+	bom := sbom.NewDocument()
+	bom.NodeList = nl
+
+	doc, err := s.SerializeContext(ctx, bom, opts, nil)
+	if err != nil {
+		return fmt.Errorf("serializing node list: %w", err)
+	}
+
+	return s.Render(doc, wr, ro, nil)
+}
+
+// SerializeToBytes serializes a document and renders it in one call,
+// returning the encoded CycloneDX document. The output is not indented.
+func (s *CDX) SerializeToBytes(
+	ctx context.Context, bom *sbom.Document, opts *native.SerializeOptions,
+) ([]byte, error) {
+	doc, err := s.SerializeContext(ctx, bom, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := s.Render(doc, &buf, &native.RenderOptions{Compact: true}, nil); err != nil {
+		return nil, fmt.Errorf("rendering document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// SerializeContext works like Serialize, but stops and returns the context
+// error as soon as ctx is canceled.
+func (s *CDX) SerializeContext(
+	ctx context.Context, bom *sbom.Document, opts *native.SerializeOptions, fopts interface{},
+) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Load the context with the CDX value
 	state := newSerializerCDXState()
-	ctx := context.WithValue(context.Background(), stateKey, state)
+	ctx = context.WithValue(ctx, stateKey, state)
+
+	// Nodes sharing an ID would be written with the same identifier
+	bom, merged, err := opts.CheckDuplicateIDs(bom)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to cyclonedx: %w", err)
+	}
+	if merged > 0 {
+		logrus.Warnf("merged %d nodes with duplicate IDs", merged)
+	}
+
+	// Prune a copy of the document, the original is left untouched
+	if opts != nil && opts.PruneUnreachable {
+		bom = &sbom.Document{Metadata: bom.Metadata, NodeList: bom.NodeList.Copy()}
+		if removed := bom.PruneUnreachable(); removed > 0 {
+			logrus.Infof("pruned %d unreachable nodes", removed)
+		}
+	}
+
+	// Check the size before building anything, the document may be untrusted
+	bom, truncated, err := opts.LimitComponents(bom)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to cyclonedx: %w", err)
+	}
+	if truncated > 0 {
+		logrus.Warnf("document truncated to %d components, %d left out", opts.MaxComponents, truncated)
+		state.degrade(native.Degradation{
+			Field:   "components",
+			Message: fmt.Sprintf("%d components over the limit of %d left out", truncated, opts.MaxComponents),
+		})
+	}
 
 	doc := cdx.NewBOM()
-	doc.SerialNumber = bom.Metadata.Id
-	ver, err := strconv.Atoi(bom.Metadata.Version)
-	// TODO(deprecation): If version does not parse to int, there's data loss here.
-	if err == nil {
-		doc.Version = ver
+	if err := setDocumentIdentity(doc, bom.Metadata, opts); err != nil {
+		return nil, fmt.Errorf("unable to serialize to cyclonedx: %w", err)
+	}
+	s.setDocumentExternalReferences(doc, bom.Metadata, opts)
+	for _, d := range s.metadataDegradations(bom.Metadata) {
+		state.degrade(d)
 	}
 
 	metadata := cdx.Metadata{
@@ -57,6 +155,9 @@ func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interf
 	}
 
 	doc.Metadata = &metadata
+	if date := opts.DocumentDate(bom.Metadata); date != nil {
+		metadata.Timestamp = date.UTC().Format(time.RFC3339)
+	}
 	doc.Components = &[]cdx.Component{}
 	doc.Dependencies = &[]cdx.Dependency{}
 
@@ -71,214 +172,1663 @@ func (s *CDX) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interf
 		return nil, fmt.Errorf("unable to build cyclonedx document, no root nodes found")
 	}
 
-	// .. or has too many root elements:
-
-	// TODO(deprecation): If there are more root nodes we need to hack them
-	// into the CycloneDX graph or error
+	// .. or has too many root elements. CycloneDX documents have a single
+	// subject, extra roots are handled as configured in the options.
 	if l := len(bom.NodeList.RootElements); l > 1 {
-		return nil, fmt.Errorf("unable to serialize multiroot cyclonedx, document has %d root nodes", l)
+		strategy := native.MultipleRootsError
+		if opts != nil {
+			strategy = opts.MultipleRoots
+		}
+		switch strategy {
+		case native.MultipleRootsError:
+			return nil, fmt.Errorf("unable to serialize multiroot cyclonedx, document has %d root nodes", l)
+		case native.MultipleRootsComponents:
+			// The roots not contained by other nodes end up in the top
+			// level components list
+		case native.MultipleRootsAggregate:
+			bom = withAggregateRoot(bom)
+		default:
+			return nil, fmt.Errorf("unknown multiple roots strategy %q", strategy)
+		}
+	}
+
+	rootNode := bom.NodeList.GetNodeByID(bom.NodeList.RootElements[0])
+	if rootNode == nil {
+		return nil, fmt.Errorf("integrity error: root node %q not found", bom.NodeList.RootElements[0])
+	}
+
+	if opts != nil && opts.BOMRefStrategy == native.BOMRefPurlHash {
+		bom = withPurlHashRefs(bom)
+		rootNode = bom.NodeList.GetNodeByID(bom.NodeList.RootElements[0])
+	}
+
+	if err := s.componentsMaps(ctx, bom); err != nil {
+		return nil, err
+	}
+
+	if opts != nil && opts.ExtraSuppliers != native.ExtraSuppliersDrop &&
+		opts.ExtraSuppliers != native.ExtraSuppliersProperties {
+		return nil, fmt.Errorf("unknown extra suppliers strategy %q", opts.ExtraSuppliers)
+	}
+
+	// The component options are applied before the components get nested
+	for _, n := range bom.NodeList.Nodes {
+		if c, ok := state.componentsDict[n.Id]; ok {
+			if err := applyComponentOptions(c, n, opts); err != nil {
+				return nil, err
+			}
+			for _, d := range s.componentDegradations(c, n, opts) {
+				state.degrade(d)
+			}
+		}
+	}
+
+	// The root component is taken from the components dictionary to make
+	// sure the nodes it contains get nested under metadata.component
+	doc.Metadata.Component = state.componentsDict[rootNode.Id]
+	state.addedDict[rootNode.Id] = struct{}{}
+
+	if err := s.fillMetadata(&metadata, bom, opts); err != nil {
+		return nil, err
+	}
+
+	if bom.Metadata != nil && bom.GetMetadata().GetName() != "" {
+		doc.Metadata.Component.Name = bom.GetMetadata().GetName()
+	}
+
+	// Edge properties and patches are added before the components get
+	// nested, the nested components are copies
+	if err := s.edgeProperties(ctx, bom, opts); err != nil {
+		return nil, err
+	}
+	if err := s.edgePatches(ctx, bom, opts); err != nil {
+		return nil, err
+	}
+
+	deps, err := s.dependencies(ctx, bom, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.ContainsDependencies {
+		deps = withContainsDependencies(deps, bom.NodeList)
+	}
+	if opts != nil && opts.RootDependencies {
+		deps = withRootChildren(deps, bom.NodeList, rootNode.Id, opts)
+	}
+	deps = withRootDependency(deps, rootNode.Id)
+	if opts != nil && opts.FlattenDependencies {
+		deps = flattenDependencies(deps)
+	}
+	if opts != nil && opts.SortDependencies {
+		sortDependencyTargets(deps)
+	}
+	doc.Dependencies = &deps
+
+	if o, ok := fopts.(*CDXOptions); ok && o != nil && o.Diagnostics != nil {
+		*o.Diagnostics = *state.diagnostics(rootNode.Id)
+	}
+
+	components := state.components()
+	clearAutoRefs(&components)
+	doc.Components = &components
+	if doc.Metadata.Component.Components != nil {
+		clearAutoRefs(doc.Metadata.Component.Components)
+	}
+
+	if err := finishComponent(doc.Metadata.Component, opts); err != nil {
+		return nil, err
+	}
+	for i := range *doc.Components {
+		if err := finishComponent(&(*doc.Components)[i], opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// Groups are added after the properties are cleared, they have no
+	// meaning without their marker
+	if opts != nil && opts.GroupComponentsByType {
+		*doc.Components = groupComponentsByType(*doc.Components)
+		if doc.Metadata.Component.Components != nil {
+			*doc.Metadata.Component.Components = groupComponentsByType(*doc.Metadata.Component.Components)
+		}
+	}
+
+	// The encoders silently replace invalid UTF-8, clean it here to report it
+	utf8Findings := sanitizeBOMStrings(doc)
+	if len(utf8Findings) > 0 && opts != nil && opts.StrictUTF8 {
+		return nil, fmt.Errorf("document has %d invalid UTF-8 strings: %s", len(utf8Findings), utf8Findings[0])
+	}
+	for _, f := range utf8Findings {
+		logrus.Warnf("cyclonedx lint: %s", f)
+		state.degrade(f.degradation())
+	}
+
+	// The encoder writes enum strings verbatim, so catch the values invalid
+	// in the target spec version here
+	for _, f := range lintBOM(doc, cdxformats.ValidEnums(s.specVersion())) {
+		logrus.Warnf("cyclonedx lint: %s", f)
+		state.degrade(f.degradation())
+	}
+
+	// A component must only be written once, either nested or at the top level
+	for _, ref := range duplicateBOMRefs(doc) {
+		logrus.Warnf("cyclonedx lint: component %q appears more than once in the document", ref)
+		state.degrade(native.Degradation{
+			NodeID: ref, Field: "bom-ref", Message: "component appears more than once in the document",
+		})
+	}
+
+	if o, ok := fopts.(*CDXOptions); ok && o != nil && o.ConversionAnnotation {
+		if s.specVersion() < cdx.SpecVersion1_5 {
+			logrus.Warnf("annotations require CycloneDX 1.5, conversion annotation not added")
+		} else {
+			ts := time.Now().UTC()
+			if opts != nil && opts.Timestamp != nil {
+				ts = *opts.Timestamp
+			}
+			doc.Annotations = &[]cdx.Annotation{
+				conversionAnnotation(doc, bom.GetMetadata().GetSourceFormat(), state.degradations, ts),
+			}
+		}
+	}
+
+	prefix := propertyPrefix(opts)
+	cdxformats.RebaseProperties(doc, cdxformats.PropertyPrefix, prefix)
+
+	// The digest is computed last to capture the final components
+	if opts != nil && opts.ComponentsSHA256 {
+		digest, err := componentsSHA256(doc)
+		if err != nil {
+			return nil, fmt.Errorf("computing components digest: %w", err)
+		}
+		if metadata.Properties == nil {
+			metadata.Properties = &[]cdx.Property{}
+		}
+		*metadata.Properties = append(*metadata.Properties, cdx.Property{
+			Name:  prefix + strings.TrimPrefix(cdxformats.PropertyComponentsSHA256, cdxformats.PropertyPrefix),
+			Value: digest,
+		})
+	}
+
+	opts.CollectDegradations(state.degradations)
+
+	return doc, nil
+}
+
+// setDocumentIdentity sets the serial number and version of the CDX
+// document from the protobom metadata. Versions that are not a number
+// keep the default version, fillMetadata records them in a property
+// unless properties are disabled.
+func setDocumentIdentity(doc *cdx.BOM, md *sbom.Metadata, opts *native.SerializeOptions) error {
+	// The serial number must be a UUID URN, identifiers read from other
+	// formats (eg the SPDX document ID) are not carried over.
+	if _, err := uuid.Parse(md.GetId()); err == nil && strings.HasPrefix(md.GetId(), "urn:uuid:") {
+		doc.SerialNumber = md.GetId()
+	}
+	if !isNumericVersion(md.GetVersion()) {
+		if opts != nil && opts.StrictVersion {
+			return fmt.Errorf("document version %q is not a number", md.GetVersion())
+		}
+		return nil
+	}
+	// CycloneDX versions start at 1, lower values keep the default.
+	if ver, err := strconv.Atoi(md.GetVersion()); err == nil && ver > 0 {
+		doc.Version = ver
+	}
+	return nil
+}
+
+// setDocumentExternalReferences writes the external references of the
+// protobom document to the top level external references of the CDX
+// document. Local paths are rewritten as in the components.
+func (s *CDX) setDocumentExternalReferences(doc *cdx.BOM, md *sbom.Metadata, opts *native.SerializeOptions) {
+	refs := s.externalReferencesToCDX(md.GetExternalReferences())
+	if len(refs) == 0 {
+		return
+	}
+	for i := range refs {
+		if isLocalPath(refs[i].URL) {
+			refs[i].URL = opts.RewritePath(refs[i].URL)
+		}
+	}
+	doc.ExternalReferences = &refs
+}
+
+// isSHA256Digest returns true if s is a hex encoded SHA-256 digest
+func isSHA256Digest(s string) bool {
+	if len(s) != hex.EncodedLen(sha256.Size) {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// isNumericVersion returns true if the document version can be written as
+// the CDX version. Empty versions are considered numeric, there is nothing
+// to preserve.
+func isNumericVersion(v string) bool {
+	if v == "" {
+		return true
+	}
+	_, err := strconv.Atoi(v)
+	return err == nil
+}
+
+// fillMetadata writes the document level data of the protobom metadata
+// (lifecycles, authors, suppliers, tools and the protobom properties) to
+// the CDX metadata.
+func (s *CDX) fillMetadata(metadata *cdx.Metadata, bom *sbom.Document, opts *native.SerializeOptions) error {
+	lifecycles, err := s.lifecycles(bom.Metadata)
+	if err != nil {
+		return err
+	}
+	*metadata.Lifecycles = lifecycles
+
+	// CDX lifecycles have no type, flag the names of the OTHER document
+	// types to read them back with their type
+	if opts == nil || !opts.DisableProperties {
+		for _, dt := range bom.GetMetadata().GetDocumentTypes() {
+			if dt.GetType() != sbom.DocumentType_OTHER || dt.Type == nil {
+				continue
+			}
+			if metadata.Properties == nil {
+				metadata.Properties = &[]cdx.Property{}
+			}
+			*metadata.Properties = append(*metadata.Properties, cdx.Property{
+				Name: cdxformats.PropertyDocumentTypeOther, Value: dt.GetName(),
+			})
+		}
+	}
+
+	if bom.Metadata != nil && len(bom.GetMetadata().GetAuthors()) > 0 {
+		var authors []cdx.OrganizationalContact
+		for _, bomauthor := range bom.GetMetadata().GetAuthors() {
+			authors = append(authors, cdx.OrganizationalContact{
+				Name:  bomauthor.Name,
+				Email: bomauthor.Email,
+				Phone: bomauthor.Phone,
+			})
+		}
+		metadata.Authors = &authors
+	}
+
+	// CDX metadata only supports one supplier and manufacturer, see
+	// metadataDegradations
+	if len(bom.GetMetadata().GetSuppliers()) > 0 {
+		metadata.Supplier = personToOrganizationalEntity(bom.GetMetadata().GetSuppliers()[0])
+	}
+	if len(bom.GetMetadata().GetManufacturers()) > 0 {
+		metadata.Manufacture = personToOrganizationalEntity(bom.GetMetadata().GetManufacturers()[0]) //nolint:staticcheck // Replaced in 1.6 but still valid
+	}
+
+	if bom.Metadata != nil && len(bom.GetMetadata().GetTools()) > 0 {
+		var tools []cdx.Tool //nolint:staticcheck
+		for _, bomtool := range bom.GetMetadata().GetTools() {
+			tool := cdx.Tool{ //nolint:staticcheck // Tool is needed for older cdx versions
+				Vendor:  bomtool.Vendor,
+				Name:    bomtool.Name,
+				Version: bomtool.Version,
+			}
+			if hashes := s.hashesToCDX(bomtool.Hashes); len(hashes) > 0 {
+				tool.Hashes = &hashes
+			}
+			if extRefs := s.externalReferencesToCDX(bomtool.ExternalReferences); len(extRefs) > 0 {
+				tool.ExternalReferences = &extRefs
+			}
+			tools = append(tools, tool)
+		}
+		metadata.Tools = &cdx.ToolsChoice{
+			Tools: &tools,
+		}
+	}
+
+	if opts != nil && opts.AddGeneratorTool {
+		addGeneratorTool(metadata)
+	}
+
+	// The legacy tools array is deprecated since CycloneDX 1.5
+	if s.toolsAsComponents() {
+		toolsToComponents(metadata.Tools)
+	}
+
+	if v := bom.GetMetadata().GetVersion(); !isNumericVersion(v) && (opts == nil || !opts.DisableProperties) {
+		if metadata.Properties == nil {
+			metadata.Properties = &[]cdx.Property{}
+		}
+		*metadata.Properties = append(*metadata.Properties, cdx.Property{
+			Name: cdxformats.PropertyDocumentVersion, Value: v,
+		})
+	}
+
+	if digest := bom.GetMetadata().GetSourceSha256(); opts != nil && opts.SourceSHA256 && digest != "" {
+		if !isSHA256Digest(digest) {
+			return fmt.Errorf("source digest %q is not a hex encoded SHA-256 digest", digest)
+		}
+		if metadata.Properties == nil {
+			metadata.Properties = &[]cdx.Property{}
+		}
+		*metadata.Properties = append(*metadata.Properties, cdx.Property{
+			Name: cdxformats.PropertySourceSHA256, Value: digest,
+		})
+	}
+
+	return nil
+}
+
+// applyComponentOptions applies the serializer options that rewrite a single
+// component from the data of its node.
+func applyComponentOptions(c *cdx.Component, n *sbom.Node, opts *native.SerializeOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	// Override the component type
+	if t, ok := opts.NodeTypeToComponentType[n.Type]; ok {
+		c.Type = cdxformats.NormalizeComponentType(t)
+	}
+
+	if opts.CPE22Property {
+		addCPE22Property(c, n)
+	}
+
+	if opts.LicenseEvidence {
+		addLicenseEvidence(c, n)
+	}
+
+	if opts.PathRewriter != nil {
+		rewritePaths(c, opts)
+	}
+
+	if opts.ExtraSuppliers == native.ExtraSuppliersProperties {
+		if err := addExtraSuppliers(c, n); err != nil {
+			return err
+		}
+	}
+
+	if prefix := propertyPrefix(opts); prefix != cdxformats.PropertyPrefix {
+		dropReservedProperties(c, n, prefix)
+	}
+	return nil
+}
+
+// metadataDegradations lists the document metadata that could not be
+// written: a version that is not a number, the suppliers and manufacturers
+// after the first one, and the hashes and external references of the tools
+// and the document that CycloneDX cannot express.
+func (s *CDX) metadataDegradations(md *sbom.Metadata) []native.Degradation {
+	ret := []native.Degradation{}
+	degrade := func(field, format string, args ...any) {
+		ret = append(ret, native.Degradation{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if v := md.GetVersion(); !isNumericVersion(v) {
+		degrade("version", "document version %q is not a number, written as the default version", v)
+	}
+	if l := len(md.GetSuppliers()); l > 1 {
+		degrade("suppliers", "%d suppliers after the first dropped", l-1)
+	}
+	if l := len(md.GetManufacturers()); l > 1 {
+		degrade("manufacturers", "%d manufacturers after the first dropped", l-1)
+	}
+	for _, t := range md.GetTools() {
+		for _, algo := range s.unsupportedHashAlgorithms(t.GetHashes()) {
+			degrade("tools", "%s hash of tool %s dropped, the algorithm is not supported", algo, t.GetName())
+		}
+		if l := referencesWithoutURL(t.GetExternalReferences()); l > 0 {
+			degrade("tools", "%d external references of tool %s dropped, they have no URL", l, t.GetName())
+		}
+	}
+	if l := referencesWithoutURL(md.GetExternalReferences()); l > 0 {
+		degrade("external references", "%d external references dropped, they have no URL", l)
+	}
+
+	return ret
+}
+
+// unsupportedHashAlgorithms returns the algorithms of the hashes that
+// hashesToCDX leaves out because CycloneDX does not support them, sorted.
+func (s *CDX) unsupportedHashAlgorithms(hashes map[int32]string) []sbom.HashAlgorithm {
+	ret := []sbom.HashAlgorithm{}
+	for algo, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		if _, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(algo)); err != nil {
+			ret = append(ret, sbom.HashAlgorithm(algo))
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+// referencesWithoutURL returns the number of external references that
+// externalReferencesToCDX leaves out because they have no URL.
+func referencesWithoutURL(refs []*sbom.ExternalReference) int {
+	count := 0
+	for _, er := range refs {
+		if er.GetUrl() == "" {
+			count++
+		}
+	}
+	return count
+}
+
+// componentDegradations lists the data of a node that could not be written
+// to its component: the primary purposes after the first one or without a
+// component type, the CPE 2.2 identifier when there is a CPE 2.3 one, the
+// suppliers and manufacturers that did not fit, the occurrences (or their
+// details) not supported by the spec version, and the hashes and external
+// references CycloneDX cannot express.
+func (s *CDX) componentDegradations(c *cdx.Component, n *sbom.Node, opts *native.SerializeOptions) []native.Degradation {
+	ret := []native.Degradation{}
+	degrade := func(field, format string, args ...any) {
+		ret = append(ret, native.Degradation{NodeID: n.Id, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if n.Type != sbom.Node_FILE && len(n.PrimaryPurpose) > 0 {
+		if c.Type == "" {
+			degrade("primary purpose", "primary purpose %s has no component type", n.PrimaryPurpose[0])
+		}
+		if len(n.PrimaryPurpose) > 1 {
+			degrade("primary purpose", "%d primary purposes after %s dropped", len(n.PrimaryPurpose)-1, n.PrimaryPurpose[0])
+		}
+	}
+
+	cpe22 := n.GetIdentifiers()[int32(sbom.SoftwareIdentifierType_CPE22)]
+	if cpe22 != "" && c.CPE != cpe22 && (opts == nil || !opts.CPE22Property) {
+		degrade("cpe", "CPE 2.2 identifier %q dropped, only one CPE is supported", cpe22)
+	}
+
+	if len(n.GetSuppliers()) > 1 && (opts == nil || opts.ExtraSuppliers != native.ExtraSuppliersProperties) {
+		degrade("suppliers", "%d suppliers after the first dropped", len(n.GetSuppliers())-1)
+	}
+
+	if len(n.GetOccurrences()) > 0 {
+		if s.specVersion() < cdx.SpecVersion1_5 {
+			degrade("occurrences", "%d occurrences dropped, evidence occurrences require CycloneDX 1.5", len(n.GetOccurrences()))
+		} else if s.specVersion() < cdx.SpecVersion1_6 {
+			for _, o := range n.GetOccurrences() {
+				if o.Line > 0 || o.Offset > 0 || o.Symbol != "" || o.AdditionalContext != "" {
+					degrade("occurrences", "occurrence details in %q dropped, they require CycloneDX 1.6", o.Location)
+				}
+			}
+		}
+	}
+
+	// Before CycloneDX 1.6 the manufacturer is only written as the
+	// supplier when there is none
+	dropped := len(n.GetManufacturers()) - 1
+	if s.specVersion() < cdx.SpecVersion1_6 && len(n.GetSuppliers()) > 0 {
+		dropped = len(n.GetManufacturers())
+	}
+	if dropped > 0 {
+		degrade("manufacturers", "%d manufacturers dropped", dropped)
+	}
+
+	for _, algo := range s.unsupportedHashAlgorithms(n.GetHashes()) {
+		degrade("hashes", "%s hash dropped, the algorithm is not supported", algo)
+	}
+	if l := referencesWithoutURL(n.GetExternalReferences()); l > 0 {
+		degrade("external references", "%d external references dropped, they have no URL", l)
+	}
+
+	return ret
+}
+
+// finishComponent runs the output options on a component after its
+// subcomponents are nested.
+func finishComponent(c *cdx.Component, opts *native.SerializeOptions) error {
+	normalizePurls(c, opts)
+
+	if opts == nil || !opts.ForceCopyright {
+		clearCopyright(c)
+	}
+
+	if opts != nil && opts.DisableProperties {
+		clearProtobomProperties(c)
+	}
+
+	if opts != nil && opts.ComponentDetail != native.ComponentDetailFull {
+		if err := reduceComponentDetail(c, opts.ComponentDetail); err != nil {
+			return err
+		}
+	}
+
+	if opts != nil && opts.DependencyGraphOnly {
+		stripComponentDetails(c)
+	}
+	return nil
+}
+
+// componentsSHA256 returns the hex encoded SHA-256 digest of the document
+// components. The metadata component (which nests the root subcomponents)
+// and the top level components are hashed in their canonical JSON encoding.
+func componentsSHA256(doc *cdx.BOM) (string, error) {
+	data, err := json.Marshal(struct {
+		Component  *cdx.Component   `json:"component,omitempty"`
+		Components *[]cdx.Component `json:"components,omitempty"`
+	}{doc.Metadata.Component, doc.Components})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// addGeneratorTool adds protobom to the metadata tools unless it is
+// already listed there.
+func addGeneratorTool(metadata *cdx.Metadata) {
+	if metadata.Tools == nil {
+		metadata.Tools = &cdx.ToolsChoice{}
+	}
+	if metadata.Tools.Tools == nil {
+		metadata.Tools.Tools = &[]cdx.Tool{} //nolint:staticcheck
+	}
+
+	for _, t := range *metadata.Tools.Tools {
+		if t.Name == generatorToolName {
+			return
+		}
+	}
+
+	*metadata.Tools.Tools = append(*metadata.Tools.Tools, cdx.Tool{ //nolint:staticcheck // Tool is needed for older cdx versions
+		Name:    generatorToolName,
+		Version: version.GetVersionInfo().GitVersion,
+	})
+}
+
+// toolsAsComponents returns true if the metadata tools are written as
+// components for the target CDX version.
+func (s *CDX) toolsAsComponents() bool {
+	return s.specVersion() >= cdx.SpecVersion1_5
+}
+
+// toolsToComponents moves the legacy tools of the metadata to the tool
+// components, as applications published by the tool vendor.
+func toolsToComponents(tools *cdx.ToolsChoice) {
+	if tools == nil || tools.Tools == nil { //nolint:staticcheck // Converting away from the deprecated tools
+		return
+	}
+	if tools.Components == nil {
+		tools.Components = &[]cdx.Component{}
+	}
+	for _, t := range *tools.Tools { //nolint:staticcheck // Converting away from the deprecated tools
+		*tools.Components = append(*tools.Components, cdx.Component{
+			Type:               cdx.ComponentTypeApplication,
+			Publisher:          t.Vendor,
+			Name:               t.Name,
+			Version:            t.Version,
+			Hashes:             t.Hashes,
+			ExternalReferences: t.ExternalReferences,
+		})
+	}
+	tools.Tools = nil //nolint:staticcheck // Converting away from the deprecated tools
+}
+
+// lifecycles returns the CDX lifecycles of the document. They are read
+// from the metadata lifecycles list and, for backwards compatibility,
+// from the document types. Lifecycles found in both are emitted once.
+func (s *CDX) lifecycles(md *sbom.Metadata) ([]cdx.Lifecycle, error) {
+	lifecycles := []cdx.Lifecycle{}
+	seen := map[string]struct{}{}
+	add := func(lfc cdx.Lifecycle) {
+		key := string(lfc.Phase)
+		if lfc.Phase == "" {
+			key = "name:" + lfc.Name
+		}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		lifecycles = append(lifecycles, lfc)
+	}
+
+	for _, l := range md.GetLifecycles() {
+		if l.Phase == sbom.Lifecycle_CUSTOM {
+			add(cdx.Lifecycle{Name: l.Name, Description: l.Description})
+			continue
+		}
+		add(cdx.Lifecycle{Phase: lifecyclePhaseToCDX(l.Phase)})
+	}
+
+	for _, dt := range md.GetDocumentTypes() {
+		var lfc cdx.Lifecycle
+		var err error
+
+		// Custom types are written as named lifecycles, preserving their
+		// name and description as they are
+		if dt.Type == nil || dt.GetType() == sbom.DocumentType_OTHER {
+			lfc.Name = dt.GetName()
+			lfc.Description = dt.GetDescription()
+		} else {
+			lfc.Phase, err = sbomTypeToPhase(dt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		add(lfc)
+	}
+
+	return lifecycles, nil
+}
+
+// lifecyclePhaseToCDX converts a protobom lifecycle phase to its CDX phase
+func lifecyclePhaseToCDX(phase sbom.Lifecycle_Phase) cdx.LifecyclePhase {
+	switch phase {
+	case sbom.Lifecycle_DESIGN:
+		return cdx.LifecyclePhaseDesign
+	case sbom.Lifecycle_PRE_BUILD:
+		return cdx.LifecyclePhasePreBuild
+	case sbom.Lifecycle_BUILD:
+		return cdx.LifecyclePhaseBuild
+	case sbom.Lifecycle_POST_BUILD:
+		return cdx.LifecyclePhasePostBuild
+	case sbom.Lifecycle_OPERATIONS:
+		return cdx.LifecyclePhaseOperations
+	case sbom.Lifecycle_DISCOVERY:
+		return cdx.LifecyclePhaseDiscovery
+	case sbom.Lifecycle_DECOMMISSION:
+		return cdx.LifecyclePhaseDecommission
+	default:
+		return ""
+	}
+}
+
+// sbomTypeToPhase converts a SBOM document type to a CDX lifecycle phase
+func sbomTypeToPhase(dt *sbom.DocumentType) (cdx.LifecyclePhase, error) {
+	switch *dt.Type {
+	case sbom.DocumentType_BUILD:
+		return cdx.LifecyclePhaseBuild, nil
+	case sbom.DocumentType_DESIGN:
+		return cdx.LifecyclePhaseDesign, nil
+	case sbom.DocumentType_ANALYZED:
+		return cdx.LifecyclePhasePostBuild, nil
+	case sbom.DocumentType_SOURCE:
+		return cdx.LifecyclePhasePreBuild, nil
+	case sbom.DocumentType_DECOMISSION:
+		return cdx.LifecyclePhaseDecommission, nil
+	case sbom.DocumentType_DEPLOYED:
+		return cdx.LifecyclePhaseOperations, nil
+	case sbom.DocumentType_DISCOVERY:
+		return cdx.LifecyclePhaseDiscovery, nil
+	}
+	// TODO(option): Dont err but assign to type OTHER
+	return "", fmt.Errorf("unknown document type %s", dt.GetName())
+}
+
+// clearAutoRefs
+// The last step of the CDX serialization recursively removes all autogenerated
+// refs added by the protobom reader. These are added on CycloneDX ingestion
+// to all nodes that don't have them. To maintain the closest fidelity, we
+// clear their refs again before output to CDX
+func clearAutoRefs(comps *[]cdx.Component) {
+	for i := range *comps {
+		if strings.HasPrefix((*comps)[i].BOMRef, "protobom-") {
+			flags := strings.Split((*comps)[i].BOMRef, "--")
+			if strings.Contains(flags[0], "-auto") {
+				(*comps)[i].BOMRef = ""
+			}
+		}
+		if (*comps)[i].Components != nil && len(*(*comps)[i].Components) != 0 {
+			clearAutoRefs((*comps)[i].Components)
+		}
+	}
+}
+
+// groupComponentsByType returns the components nested under synthetic group
+// components, one for each component type in the order they are first found.
+// Components without a type are not grouped.
+func groupComponentsByType(comps []cdx.Component) []cdx.Component {
+	grouped := []cdx.Component{}
+	groups := map[cdx.ComponentType]int{}
+	for _, c := range comps {
+		if c.Type == "" {
+			grouped = append(grouped, c)
+			continue
+		}
+
+		i, ok := groups[c.Type]
+		if !ok {
+			i = len(grouped)
+			groups[c.Type] = i
+			grouped = append(grouped, cdx.Component{
+				Type:       c.Type,
+				Name:       string(c.Type),
+				Components: &[]cdx.Component{},
+				Properties: &[]cdx.Property{
+					{Name: cdxformats.PropertyGroup, Value: string(c.Type)},
+				},
+			})
+		}
+		*grouped[i].Components = append(*grouped[i].Components, c)
+	}
+	return grouped
+}
+
+// normalizePurls runs the package URLs of a component and its subcomponents
+// through the purl normalizer of the serializer options.
+func normalizePurls(c *cdx.Component, opts *native.SerializeOptions) {
+	if c.PackageURL != "" {
+		c.PackageURL = opts.NormalizePURL(c.PackageURL)
+	}
+
+	// Alternate purls are stored as properties
+	if c.Properties != nil {
+		for i := range *c.Properties {
+			if (*c.Properties)[i].Name == cdxformats.PropertyIdentifierPrefix+"purl" {
+				(*c.Properties)[i].Value = opts.NormalizePURL((*c.Properties)[i].Value)
+			}
+		}
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			normalizePurls(&(*c.Components)[i], opts)
+		}
+	}
+}
+
+// stripComponentDetails removes all the data of a component and its
+// subcomponents except the fields needed to read the graph structure.
+func stripComponentDetails(c *cdx.Component) {
+	*c = cdx.Component{
+		BOMRef:     c.BOMRef,
+		Type:       c.Type,
+		Name:       c.Name,
+		Components: c.Components,
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			stripComponentDetails(&(*c.Components)[i])
+		}
+	}
+}
+
+// reduceComponentDetail drops the data of a component and its subcomponents
+// not included in the detail level.
+func reduceComponentDetail(c *cdx.Component, level native.ComponentDetail) error {
+	switch level {
+	case native.ComponentDetailFull:
+		return nil
+	case native.ComponentDetailStandard:
+		*c = cdx.Component{
+			BOMRef:       c.BOMRef,
+			Type:         c.Type,
+			Supplier:     c.Supplier,
+			Manufacturer: c.Manufacturer,
+			Group:        c.Group,
+			Name:         c.Name,
+			Version:      c.Version,
+			Scope:        c.Scope,
+			Hashes:       c.Hashes,
+			Licenses:     c.Licenses,
+			CPE:          c.CPE,
+			PackageURL:   c.PackageURL,
+			Components:   c.Components,
+		}
+	case native.ComponentDetailLite:
+		// The type is required by the spec
+		*c = cdx.Component{
+			BOMRef:     c.BOMRef,
+			Type:       c.Type,
+			Name:       c.Name,
+			Version:    c.Version,
+			PackageURL: c.PackageURL,
+			Components: c.Components,
+		}
+	default:
+		return fmt.Errorf("unknown component detail level %q", level)
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			if err := reduceComponentDetail(&(*c.Components)[i], level); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// clearProtobomProperties removes the properties in the protobom namespace
+// from a component and its subcomponents.
+func clearProtobomProperties(c *cdx.Component) {
+	if c.Properties != nil {
+		props := []cdx.Property{}
+		for _, p := range *c.Properties {
+			if strings.HasPrefix(p.Name, cdxformats.PropertyPrefix) &&
+				!strings.HasPrefix(p.Name, cdxformats.PropertyBuildPrefix) {
+				continue
+			}
+			props = append(props, p)
+		}
+		c.Properties = nil
+		if len(props) > 0 {
+			c.Properties = &props
+		}
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			clearProtobomProperties(&(*c.Components)[i])
+		}
+	}
+}
+
+// propertyPrefix returns the namespace the protobom properties are written
+// under, the protobom one unless the options set a custom prefix.
+func propertyPrefix(opts *native.SerializeOptions) string {
+	if opts != nil && opts.PropertyPrefix != "" {
+		return opts.PropertyPrefix
+	}
+	return cdxformats.PropertyPrefix
+}
+
+// dropReservedProperties removes the node properties in a custom property
+// namespace from a component. They would be read back as protobom data.
+func dropReservedProperties(c *cdx.Component, n *sbom.Node, prefix string) {
+	if c.Properties == nil {
+		return
+	}
+	props := []cdx.Property{}
+	for _, p := range *c.Properties {
+		if strings.HasPrefix(p.Name, prefix) && !strings.HasPrefix(p.Name, cdxformats.PropertyPrefix) {
+			logrus.Warnf("node %s: dropping property %q, the %s namespace is reserved", n.Id, p.Name, prefix)
+			continue
+		}
+		props = append(props, p)
+	}
+	c.Properties = nil
+	if len(props) > 0 {
+		c.Properties = &props
+	}
+}
+
+// noCopyrightTypes lists the component types that do not conventionally
+// carry a copyright notice.
+var noCopyrightTypes = map[cdx.ComponentType]struct{}{
+	cdx.ComponentTypeDevice:   {},
+	cdx.ComponentTypeOS:       {},
+	cdx.ComponentTypePlatform: {},
+}
+
+// clearCopyright removes the copyright from a component and its
+// subcomponents when their type does not carry one.
+func clearCopyright(c *cdx.Component) {
+	if _, ok := noCopyrightTypes[c.Type]; ok {
+		c.Copyright = ""
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			clearCopyright(&(*c.Components)[i])
+		}
+	}
+}
+
+func (s *CDX) componentsMaps(ctx context.Context, bom *sbom.Document) error {
+	state, err := getCDXState(ctx)
+	if err != nil {
+		return fmt.Errorf("reading state: %w", err)
+	}
+
+	for i, n := range bom.NodeList.Nodes {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		comp := s.nodeToComponent(n)
+		if comp == nil {
+			// Error? Warn?
+			continue
+		}
+
+		if _, ok := state.componentsDict[comp.BOMRef]; !ok {
+			state.componentRefs = append(state.componentRefs, comp.BOMRef)
+		}
+		state.componentsDict[comp.BOMRef] = comp
+	}
+	return nil
+}
+
+// NOTE dependencies function modifies the components dictionary. The edges
+// are nested or listed as dependencies as configured in the options.
+func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document, opts *native.SerializeOptions) ([]cdx.Dependency, error) {
+	state, err := getCDXState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading state: %w", err)
+	}
+
+	// Documents relating their nodes only with dependencies (common for
+	// language package managers) have nothing to nest
+	if dependencyEdgesOnly(bom.NodeList.Edges, opts) {
+		return flatDependencies(ctx, state, bom.NodeList.Edges)
+	}
+	return graphDependencies(ctx, state, bom, opts)
+}
+
+// dependencyEdgesOnly returns true if all the edges are written to the
+// dependency graph.
+func dependencyEdgesOnly(edges []*sbom.Edge, opts *native.SerializeOptions) bool {
+	for _, e := range edges {
+		if opts.EdgeRepresentation(e.Type) != native.EdgeDependency {
+			return false
+		}
+	}
+	return true
+}
+
+// flatDependencies builds the dependency graph of edges that are all
+// written as dependencies. It produces the same graph as graphDependencies
+// without the bookkeeping needed to nest components.
+func flatDependencies(ctx context.Context, state *serializerCDXState, edges []*sbom.Edge) ([]cdx.Dependency, error) {
+	type depKey struct{ from, to string }
+
+	dependencies := make([]cdx.Dependency, 0, len(edges))
+	depIndex := make(map[string]int, len(edges))
+	seen := map[depKey]struct{}{}
+
+	for i, e := range edges {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := state.componentsDict[e.From]; !ok {
+			return nil, fmt.Errorf("unable to find component %s", e.From)
+		}
+
+		idx, ok := depIndex[e.From]
+		if !ok {
+			idx = len(dependencies)
+			depIndex[e.From] = idx
+			targets := make([]string, 0, len(e.To))
+			dependencies = append(dependencies, cdx.Dependency{Ref: e.From, Dependencies: &targets})
+		}
+		targets := dependencies[idx].Dependencies
+		for _, targetID := range e.To {
+			key := depKey{e.From, targetID}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			if _, ok := state.componentsDict[targetID]; !ok {
+				return nil, fmt.Errorf("unable to locate node %s", targetID)
+			}
+			seen[key] = struct{}{}
+			*targets = append(*targets, targetID)
+		}
+	}
+	return dependencies, nil
+}
+
+// graphDependencies builds the dependency graph, nesting the components
+// of the edges configured to be nested.
+func graphDependencies(ctx context.Context, state *serializerCDXState, bom *sbom.Document, opts *native.SerializeOptions) ([]cdx.Dependency, error) {
+	var dependencies []cdx.Dependency
+
+	// Formats like SPDX have one relationship per target, the edges from
+	// the same node are merged into a single dependency entry.
+	depIndex := map[string]int{}
+	depListCheck := map[string]map[string]struct{}{}
+
+	for i, e := range bom.NodeList.Edges {
+		e := e
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := state.componentsDict[e.From]; !ok {
+			logrus.Info("serialize")
+			return nil, fmt.Errorf("unable to find component %s", e.From)
+		}
+
+		// By default, components related with a "contains" relationship
+		// are tree-ified and "dependsOn" ones go to the dependency graph
+		switch opts.EdgeRepresentation(e.Type) {
+		case native.EdgeNested:
+			// Components already nested into another one are skipped. The
+			// root is also in the added list but its children are nested
+			// under the metadata component.
+			if _, ok := state.addedDict[e.From]; ok && !slices.Contains(bom.NodeList.RootElements, e.From) {
+				continue
+			}
+
+			// Make sure we have the target component
+			for _, targetID := range e.To {
+				state.addedDict[targetID] = struct{}{}
+				if _, ok := state.componentsDict[targetID]; !ok {
+					return nil, fmt.Errorf("unable to locate node %s", targetID)
+				}
+
+				if state.componentsDict[e.From].Components == nil {
+					state.componentsDict[e.From].Components = &[]cdx.Component{}
+				}
+				*state.componentsDict[e.From].Components = append(*state.componentsDict[e.From].Components, *state.componentsDict[targetID])
+			}
+
+		case native.EdgeDependency:
+			// Add to the dependency tree
+			if _, ok := depIndex[e.From]; !ok {
+				depIndex[e.From] = len(dependencies)
+				depListCheck[e.From] = map[string]struct{}{}
+				dependencies = append(dependencies, cdx.Dependency{
+					Ref:          e.From,
+					Dependencies: &[]string{},
+				})
+			}
+			targetStrings := dependencies[depIndex[e.From]].Dependencies
+			for _, targetID := range e.To {
+				// Add entries to dependency only once.
+				if _, ok := depListCheck[e.From][targetID]; ok {
+					continue
+				}
+
+				if _, ok := state.componentsDict[targetID]; !ok {
+					return nil, fmt.Errorf("unable to locate node %s", targetID)
+				}
+
+				depListCheck[e.From][targetID] = struct{}{}
+				*targetStrings = append(*targetStrings, targetID)
+			}
+		case native.EdgeProperty:
+			// Written to the component properties, see edgeProperties
+		case native.EdgeDropped:
+			logrus.Warnf(
+				"node %s is related with %s to %d other nodes, data will be lost",
+				e.From, e.Type, len(e.To),
+			)
+			state.degrade(native.Degradation{
+				NodeID:  e.From,
+				Field:   "relationships",
+				Message: fmt.Sprintf("%s relationships to %d nodes dropped", e.Type, len(e.To)),
+			})
+		default:
+			return nil, fmt.Errorf("unknown representation %q for %s edges", opts.EdgeRepresentation(e.Type), e.Type)
+		}
+	}
+
+	return dependencies, nil
+}
+
+// edgeProperties records the edges configured to be written as properties
+// in the components they originate from.
+func (s *CDX) edgeProperties(ctx context.Context, bom *sbom.Document, opts *native.SerializeOptions) error {
+	state, err := getCDXState(ctx)
+	if err != nil {
+		return fmt.Errorf("reading state: %w", err)
+	}
+
+	for _, e := range bom.NodeList.Edges {
+		if opts.EdgeRepresentation(e.Type) != native.EdgeProperty {
+			continue
+		}
+		c, ok := state.componentsDict[e.From]
+		if !ok {
+			return fmt.Errorf("unable to find component %s", e.From)
+		}
+		for _, targetID := range e.To {
+			if _, ok := state.componentsDict[targetID]; !ok {
+				return fmt.Errorf("unable to locate node %s", targetID)
+			}
+			if c.Properties == nil {
+				c.Properties = &[]cdx.Property{}
+			}
+			*c.Properties = append(*c.Properties, cdx.Property{
+				Name: cdxformats.PropertyEdgePrefix + e.Type.String(), Value: targetID,
+			})
+		}
+	}
+	return nil
+}
+
+// edgePatches records the nodes related to others with a patch edge as
+// patches in the pedigree of the patched components. The edges themselves
+// are written as configured in the options.
+func (s *CDX) edgePatches(ctx context.Context, bom *sbom.Document, opts *native.SerializeOptions) error {
+	if s.specVersion() < cdx.SpecVersion1_2 {
+		return nil
+	}
+	state, err := getCDXState(ctx)
+	if err != nil {
+		return fmt.Errorf("reading state: %w", err)
+	}
+
+	for _, e := range bom.NodeList.Edges {
+		if e.Type != sbom.Edge_patch || opts.EdgeRepresentation(e.Type) == native.EdgeDropped {
+			continue
+		}
+		patch := bom.NodeList.GetNodeByID(e.From)
+		if patch == nil {
+			return fmt.Errorf("unable to find node %s", e.From)
+		}
+		for _, targetID := range e.To {
+			c, ok := state.componentsDict[targetID]
+			if !ok {
+				return fmt.Errorf("unable to locate node %s", targetID)
+			}
+			addPatch(c, patchToCDX(patch))
+		}
+	}
+	return nil
+}
+
+// patchToCDX converts the node of a patch to a CDX pedigree patch. The
+// first external reference of the node is the diff of the patch, and its
+// issue tracker and security references are the issues it resolves, with
+// the reference comment as the issue ID. protobom does not record how
+// the patch was made, patches are written as unofficial.
+func patchToCDX(n *sbom.Node) cdx.Patch {
+	patch := cdx.Patch{Type: cdx.PatchTypeUnofficial}
+	var resolves []cdx.Issue
+	for _, er := range n.GetExternalReferences() {
+		if er.GetUrl() == "" {
+			continue
+		}
+		switch er.GetType() {
+		case sbom.ExternalReference_ISSUE_TRACKER:
+			resolves = append(resolves, cdx.Issue{
+				Type: cdx.IssueTypeDefect, ID: er.GetComment(), References: &[]string{er.GetUrl()},
+			})
+		case sbom.ExternalReference_SECURITY_ADVISORY, sbom.ExternalReference_SECURITY_FIX:
+			resolves = append(resolves, cdx.Issue{
+				Type: cdx.IssueTypeSecurity, ID: er.GetComment(), References: &[]string{er.GetUrl()},
+			})
+		default:
+			if patch.Diff == nil {
+				patch.Diff = &cdx.Diff{URL: er.GetUrl()}
+			}
+		}
+	}
+	if len(resolves) > 0 {
+		patch.Resolves = &resolves
+	}
+	return patch
+}
+
+// addPatch adds a patch to the pedigree of a component.
+func addPatch(c *cdx.Component, patch cdx.Patch) {
+	if c.Pedigree == nil {
+		c.Pedigree = &cdx.Pedigree{}
+	}
+	if c.Pedigree.Patches == nil {
+		c.Pedigree.Patches = &[]cdx.Patch{}
+	}
+	*c.Pedigree.Patches = append(*c.Pedigree.Patches, patch)
+}
+
+// personToOrganizationalEntity converts a protobom person to a CDX
+// organizational entity
+func personToOrganizationalEntity(p *sbom.Person) *cdx.OrganizationalEntity {
+	oe := &cdx.OrganizationalEntity{
+		Name: p.GetName(),
+	}
+	if p.GetUrl() != "" {
+		oe.URL = &[]string{p.GetUrl()}
+	}
+	if p.Contacts != nil {
+		var contacts []cdx.OrganizationalContact
+		for _, nodecontact := range p.GetContacts() {
+			newcontact := cdx.OrganizationalContact{
+				Name:  nodecontact.GetName(),
+				Email: nodecontact.GetEmail(),
+				Phone: nodecontact.GetPhone(),
+			}
+			contacts = append(contacts, newcontact)
+		}
+		oe.Contact = &contacts
+	}
+	return oe
+}
+
+// hashesToCDX converts a protobom hash map to a list of CDX hashes. The
+// list is preallocated to its final size, nodes can have many entries.
+func (s *CDX) hashesToCDX(hashes map[int32]string) []cdx.Hash {
+	ret := make([]cdx.Hash, 0, len(hashes))
+	for algo, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		cdxAlgo, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(algo))
+		if err != nil {
+			// Algorithm not supported in CDX, see unsupportedHashAlgorithms
+			continue
+		}
+		ret = append(ret, cdx.Hash{
+			Algorithm: cdxAlgo,
+			Value:     hash,
+		})
+	}
+	return ret
+}
+
+// externalReferencesToCDX converts a list of protobom external references to CDX
+func (s *CDX) externalReferencesToCDX(refs []*sbom.ExternalReference) []cdx.ExternalReference {
+	ret := make([]cdx.ExternalReference, 0, len(refs))
+	for _, er := range refs {
+		// The URL is required in CDX, see referencesWithoutURL
+		if er.Url == "" {
+			continue
+		}
+		cdxRef := cdx.ExternalReference{
+			URL:     er.Url,
+			Comment: er.Comment,
+			Type:    s.protobomExtRefTypeToCdxType(er.Type),
+		}
+		// Types without a CDX equivalent are written as other, the
+		// original type is kept at the start of the comment
+		if cdxRef.Type == cdx.ERTypeOther && er.Type != sbom.ExternalReference_OTHER && er.Type != sbom.ExternalReference_UNKNOWN {
+			cdxRef.Comment = cdxformats.ExtRefTypeCommentPrefix + strings.ToLower(er.Type.String())
+			if er.Comment != "" {
+				cdxRef.Comment += " " + er.Comment
+			}
+		}
+		if hashList := s.hashesToCDX(er.Hashes); len(hashList) > 0 {
+			cdxRef.Hashes = &hashList
+		}
+		ret = append(ret, cdxRef)
+	}
+	return ret
+}
+
+// licensesToCDX converts the licenses of a node to CycloneDX license
+// choices. Single licenses are written by ID. CycloneDX only allows an
+// expression as the sole license choice, so when any of the licenses is a
+// compound expression (eg "MIT OR Apache-2.0") they are all combined in a
+// single expression.
+func licensesToCDX(nodeLicenses []string) *cdx.Licenses {
+	ids := []string{}
+	compound := false
+	for _, l := range nodeLicenses {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		compound = compound || isLicenseExpression(l)
+		ids = append(ids, l)
+	}
+
+	switch {
+	case len(ids) == 0:
+		return nil
+	case !compound:
+		licenses := make(cdx.Licenses, 0, len(ids))
+		for _, id := range ids {
+			licenses = append(licenses, cdx.LicenseChoice{License: &cdx.License{ID: id}})
+		}
+		return &licenses
+	case len(ids) == 1:
+		return &cdx.Licenses{{Expression: ids[0]}}
+	}
+
+	// WITH binds tighter than AND, only the expressions joining licenses
+	// with AND or OR need parentheses
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if hasTopLevelOperator(id) {
+			id = "(" + id + ")"
+		}
+		parts = append(parts, id)
 	}
+	return &cdx.Licenses{{Expression: strings.Join(parts, " AND ")}}
+}
 
-	rootNode := bom.NodeList.GetNodeByID(bom.NodeList.RootElements[0])
-	if rootNode == nil {
-		return nil, fmt.Errorf("integrity error: root node %q not found", bom.NodeList.RootElements[0])
+// isLicenseExpression returns true when a license string is a compound
+// SPDX license expression rather than a single license identifier.
+func isLicenseExpression(l string) bool {
+	if strings.ContainsAny(l, "()") {
+		return true
 	}
+	for _, token := range strings.Fields(l) {
+		switch strings.ToUpper(token) {
+		case "AND", "OR", "WITH":
+			return true
+		}
+	}
+	return false
+}
 
-	doc.Metadata.Component = s.nodeToComponent(rootNode)
-	state.addedDict[rootNode.Id] = struct{}{}
-
-	if err := s.componentsMaps(ctx, bom); err != nil {
-		return nil, err
+// hasTopLevelOperator returns true when a license expression joins
+// licenses with AND or OR outside of any parentheses.
+func hasTopLevelOperator(l string) bool {
+	depth := 0
+	var token strings.Builder
+	operator := func() bool {
+		t := strings.ToUpper(token.String())
+		token.Reset()
+		return depth == 0 && (t == "AND" || t == "OR")
+	}
+	for _, r := range l {
+		switch {
+		case r == '(' || r == ')' || unicode.IsSpace(r):
+			if operator() {
+				return true
+			}
+			if r == '(' {
+				depth++
+			} else if r == ')' {
+				depth--
+			}
+		default:
+			token.WriteRune(r)
+		}
 	}
+	return operator()
+}
 
-	for _, dt := range bom.Metadata.DocumentTypes {
-		var lfc cdx.Lifecycle
+// addLicenseEvidence records the copyright and licenses of a node in the
+// evidence of its component.
+func addLicenseEvidence(c *cdx.Component, n *sbom.Node) {
+	if n.GetCopyright() == "" && c.Licenses == nil {
+		return
+	}
+	if c.Evidence == nil {
+		c.Evidence = &cdx.Evidence{}
+	}
+	if n.GetCopyright() != "" {
+		c.Evidence.Copyright = &[]cdx.Copyright{{Text: n.GetCopyright()}}
+	}
+	if c.Licenses != nil {
+		licenses := slices.Clone(*c.Licenses)
+		c.Evidence.Licenses = &licenses
+	}
+}
 
-		if dt.Type == nil {
-			lfc.Name = *dt.Name
-			lfc.Description = *dt.Description
-		} else {
-			lfc.Phase, err = sbomTypeToPhase(dt)
-			if err != nil {
-				return nil, err
+// rewritePaths runs the configured path rewriter on the occurrence
+// locations and the local file external references of a component.
+func rewritePaths(c *cdx.Component, opts *native.SerializeOptions) {
+	if c.Evidence != nil && c.Evidence.Occurrences != nil {
+		for i := range *c.Evidence.Occurrences {
+			occ := &(*c.Evidence.Occurrences)[i]
+			occ.Location = opts.RewritePath(occ.Location)
+		}
+	}
+	if c.ExternalReferences != nil {
+		for i := range *c.ExternalReferences {
+			ref := &(*c.ExternalReferences)[i]
+			if isLocalPath(ref.URL) {
+				ref.URL = opts.RewritePath(ref.URL)
 			}
 		}
+	}
+}
 
-		*doc.Metadata.Lifecycles = append(*doc.Metadata.Lifecycles, lfc)
+// isLocalPath returns true if an external reference URL points to a local
+// file: a file: URL or an absolute Unix or Windows path.
+func isLocalPath(url string) bool {
+	if strings.HasPrefix(url, "file:") || strings.HasPrefix(url, "/") || strings.HasPrefix(url, `\\`) {
+		return true
 	}
+	// Windows drive paths, eg C:\ or C:/
+	return len(url) > 2 && url[1] == ':' && (url[2] == '\\' || url[2] == '/') &&
+		(url[0] >= 'a' && url[0] <= 'z' || url[0] >= 'A' && url[0] <= 'Z')
+}
 
-	if bom.Metadata != nil && len(bom.GetMetadata().GetAuthors()) > 0 {
-		var authors []cdx.OrganizationalContact
-		for _, bomauthor := range bom.GetMetadata().GetAuthors() {
-			authors = append(authors, cdx.OrganizationalContact{
-				Name:  bomauthor.Name,
-				Email: bomauthor.Email,
-				Phone: bomauthor.Phone,
-			})
-		}
-		metadata.Authors = &authors
+// occurrenceToCDX converts a node occurrence to a CycloneDX evidence
+// occurrence. Unknown (zero) lines and offsets are left out.
+func occurrenceToCDX(o *sbom.Occurrence) cdx.EvidenceOccurrence {
+	occ := cdx.EvidenceOccurrence{
+		Location:          o.Location,
+		Symbol:            o.Symbol,
+		AdditionalContext: o.AdditionalContext,
+	}
+	if o.Line > 0 {
+		line := int(o.Line)
+		occ.Line = &line
 	}
+	if o.Offset > 0 {
+		offset := int(o.Offset)
+		occ.Offset = &offset
+	}
+	return occ
+}
 
-	if bom.Metadata != nil && len(bom.GetMetadata().GetTools()) > 0 {
-		var tools []cdx.Tool //nolint:staticcheck
-		for _, bomtool := range bom.GetMetadata().GetTools() {
-			tools = append(tools, cdx.Tool{ //nolint:staticcheck // Tool is needed for older cdx versions
-				Name:    bomtool.Name,
-				Version: bomtool.Version,
-			})
+// addCPE22Property writes the CPE 2.2 identifier of a node as a property
+// of its component when the CPE field already holds the CPE 2.3 one.
+func addCPE22Property(c *cdx.Component, n *sbom.Node) {
+	cpe22 := n.GetIdentifiers()[int32(sbom.SoftwareIdentifierType_CPE22)]
+	if cpe22 == "" || c.CPE == "" || c.CPE == cpe22 {
+		return
+	}
+	if c.Properties == nil {
+		c.Properties = &[]cdx.Property{}
+	}
+	*c.Properties = append(*c.Properties, cdx.Property{
+		Name:  cdxformats.PropertyIdentifierPrefix + strings.ToLower(sbom.SoftwareIdentifierType_CPE22.String()),
+		Value: cpe22,
+	})
+}
+
+// addExtraSuppliers writes the suppliers of the node after the first one
+// as properties of the component, encoded as JSON organizational entities.
+func addExtraSuppliers(c *cdx.Component, n *sbom.Node) error {
+	if len(n.GetSuppliers()) < 2 {
+		return nil
+	}
+	for _, p := range n.GetSuppliers()[1:] {
+		data, err := json.Marshal(personToOrganizationalEntity(p))
+		if err != nil {
+			return fmt.Errorf("encoding supplier of node %s: %w", n.Id, err)
 		}
-		metadata.Tools = &cdx.ToolsChoice{
-			Tools: &tools,
+		if c.Properties == nil {
+			c.Properties = &[]cdx.Property{}
 		}
+		*c.Properties = append(*c.Properties, cdx.Property{
+			Name: cdxformats.PropertySupplier, Value: string(data),
+		})
 	}
+	return nil
+}
 
-	if bom.Metadata != nil && bom.GetMetadata().GetName() != "" {
-		doc.Metadata.Component.Name = bom.GetMetadata().GetName()
+// specVersion returns the CDX version the serializer targets. If the
+// version string is not valid, it defaults to the latest supported version.
+func (s *CDX) specVersion() cdx.SpecVersion {
+	version, err := cdxformats.ParseVersion(s.version)
+	if err != nil {
+		return cdx.SpecVersion1_6
 	}
+	return version
+}
 
-	deps, err := s.dependencies(ctx, bom)
-	if err != nil {
-		return nil, err
+// releaseNotesToCDX converts the release notes of a node to CDX
+func (s *CDX) releaseNotesToCDX(rn *sbom.ReleaseNotes) *cdx.ReleaseNotes {
+	ret := &cdx.ReleaseNotes{
+		Type:        rn.Type,
+		Title:       rn.Title,
+		Description: rn.Description,
 	}
-	doc.Dependencies = &deps
 
-	components := state.components()
-	clearAutoRefs(&components)
-	doc.Components = &components
+	if rn.Timestamp != nil {
+		ret.Timestamp = rn.Timestamp.AsTime().UTC().Format(time.RFC3339)
+	}
 
-	return doc, nil
+	if len(rn.Notes) > 0 {
+		notes := []cdx.Note{}
+		for _, n := range rn.Notes {
+			notes = append(notes, cdx.Note{
+				Locale: n.Locale,
+				Text: cdx.AttachedText{
+					Content:     n.Text,
+					ContentType: n.ContentType,
+					Encoding:    n.Encoding,
+				},
+			})
+		}
+		ret.Notes = &notes
+	}
+
+	return ret
 }
 
-// sbomTypeToPhase converts a SBOM document type to a CDX lifecycle phase
-func sbomTypeToPhase(dt *sbom.DocumentType) (cdx.LifecyclePhase, error) {
-	switch *dt.Type {
-	case sbom.DocumentType_BUILD:
-		return cdx.LifecyclePhaseBuild, nil
-	case sbom.DocumentType_DESIGN:
-		return cdx.LifecyclePhaseDesign, nil
-	case sbom.DocumentType_ANALYZED:
-		return cdx.LifecyclePhasePostBuild, nil
-	case sbom.DocumentType_SOURCE:
-		return cdx.LifecyclePhasePreBuild, nil
-	case sbom.DocumentType_DECOMISSION:
-		return cdx.LifecyclePhaseDecommission, nil
-	case sbom.DocumentType_DEPLOYED:
-		return cdx.LifecyclePhaseOperations, nil
-	case sbom.DocumentType_DISCOVERY:
-		return cdx.LifecyclePhaseDiscovery, nil
-	case sbom.DocumentType_OTHER:
-		return cdx.LifecyclePhase(strings.ToLower(*dt.Name)), nil
+// aggregateRootID is the prefix of the identifier of the synthetic root
+// added to documents with more than one root element.
+const aggregateRootID = "protobom-aggregate-root"
+
+// aggregateRootRef returns the identifier of the synthetic root of a
+// document. It is derived from the document ID and name, so it is the same
+// every time the document is serialized but differs between documents.
+func aggregateRootRef(md *sbom.Metadata) string {
+	if md.GetId() == "" && md.GetName() == "" {
+		return aggregateRootID
 	}
-	// TODO(option): Dont err but assign to type OTHER
-	return "", fmt.Errorf("unknown document type %s", *dt.Name)
+	sum := sha256.Sum256([]byte(md.GetId() + "\x00" + md.GetName()))
+	return fmt.Sprintf("%s-%x", aggregateRootID, sum[:8])
 }
 
-// clearAutoRefs
-// The last step of the CDX serialization recursively removes all autogenerated
-// refs added by the protobom reader. These are added on CycloneDX ingestion
-// to all nodes that don't have them. To maintain the closest fidelity, we
-// clear their refs again before output to CDX
-func clearAutoRefs(comps *[]cdx.Component) {
-	for i := range *comps {
-		if strings.HasPrefix((*comps)[i].BOMRef, "protobom-") {
-			flags := strings.Split((*comps)[i].BOMRef, "--")
-			if strings.Contains(flags[0], "-auto") {
-				(*comps)[i].BOMRef = ""
-			}
-		}
-		if (*comps)[i].Components != nil && len(*(*comps)[i].Components) != 0 {
-			clearAutoRefs((*comps)[i].Components)
-		}
+// withAggregateRoot returns a copy of the document with a synthetic node as
+// its only root element. The node is an application named after the
+// document which contains the original root elements. The original document
+// is not modified.
+func withAggregateRoot(bom *sbom.Document) *sbom.Document {
+	nl := bom.NodeList.Copy()
+
+	base := aggregateRootRef(bom.GetMetadata())
+	id := base
+	for i := 1; nl.GetNodeByID(id) != nil; i++ {
+		id = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	name := bom.GetMetadata().GetName()
+	if name == "" {
+		name = "aggregate"
 	}
+
+	roots := nl.RootElements
+	nl.RootElements = []string{}
+	nl.AddRootNode(&sbom.Node{Id: id, Name: name, PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	nl.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: id, To: roots})
+
+	return &sbom.Document{Metadata: bom.Metadata, NodeList: nl}
 }
 
-func (s *CDX) componentsMaps(ctx context.Context, bom *sbom.Document) error {
-	state, err := getCDXState(ctx)
-	if err != nil {
-		return fmt.Errorf("reading state: %w", err)
+// withContainsDependencies adds the targets of the contains edges of the
+// nodelist to the dependencies of their source. Targets already listed as
+// dependencies are not repeated, and the entries for sources not in the
+// list are appended in the order of the edges.
+func withContainsDependencies(deps []cdx.Dependency, nl *sbom.NodeList) []cdx.Dependency {
+	index := map[string]int{}
+	listed := map[string]map[string]struct{}{}
+	for i, d := range deps {
+		index[d.Ref] = i
+		listed[d.Ref] = map[string]struct{}{}
+		if d.Dependencies != nil {
+			for _, ref := range *d.Dependencies {
+				listed[d.Ref][ref] = struct{}{}
+			}
+		}
 	}
 
-	for _, n := range bom.NodeList.Nodes {
-		comp := s.nodeToComponent(n)
-		if comp == nil {
-			// Error? Warn?
+	for _, e := range nl.Edges {
+		if e.Type != sbom.Edge_contains || len(e.To) == 0 {
 			continue
 		}
+		if _, ok := index[e.From]; !ok {
+			index[e.From] = len(deps)
+			listed[e.From] = map[string]struct{}{}
+			deps = append(deps, cdx.Dependency{Ref: e.From})
+		}
+		d := &deps[index[e.From]]
+		if d.Dependencies == nil {
+			d.Dependencies = &[]string{}
+		}
+		for _, to := range e.To {
+			if _, ok := listed[e.From][to]; ok {
+				continue
+			}
+			listed[e.From][to] = struct{}{}
+			*d.Dependencies = append(*d.Dependencies, to)
+		}
+	}
+	return deps
+}
 
-		state.componentsDict[comp.BOMRef] = comp
+// withRootDependency lists the root component in an otherwise empty
+// dependency graph. The entry has no dependencies, which declares the root
+// has none instead of leaving the graph blank in single node documents.
+func withRootDependency(deps []cdx.Dependency, root string) []cdx.Dependency {
+	if len(deps) > 0 {
+		return deps
 	}
-	return nil
+	return []cdx.Dependency{{Ref: root}}
 }
 
-// NOTE dependencies function modifies the components dictionary
-func (s *CDX) dependencies(ctx context.Context, bom *sbom.Document) ([]cdx.Dependency, error) {
-	var dependencies []cdx.Dependency
-	state, err := getCDXState(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("reading state: %w", err)
+// withRootChildren adds the direct children of the root, the targets of
+// its edges written nested, to the dependencies of the root component. The
+// root entry is added if missing.
+func withRootChildren(deps []cdx.Dependency, nl *sbom.NodeList, root string, opts *native.SerializeOptions) []cdx.Dependency {
+	i := slices.IndexFunc(deps, func(d cdx.Dependency) bool { return d.Ref == root })
+	if i == -1 {
+		i = len(deps)
+		deps = append(deps, cdx.Dependency{Ref: root})
 	}
 
-	for _, e := range bom.NodeList.Edges {
-		e := e
-		if _, ok := state.addedDict[e.From]; ok {
+	d := &deps[i]
+	for _, e := range nl.Edges {
+		if e.From != root || opts.EdgeRepresentation(e.Type) != native.EdgeNested {
 			continue
 		}
-
-		if _, ok := state.componentsDict[e.From]; !ok {
-			logrus.Info("serialize")
-			return nil, fmt.Errorf("unable to find component %s", e.From)
+		if d.Dependencies == nil {
+			d.Dependencies = &[]string{}
 		}
-
-		// In this example, we tree-ify all components related with a
-		// "contains" relationship. This is just an opinion for the demo
-		// and it is something we can parameterize
-		switch e.Type {
-		case sbom.Edge_contains:
-			// Make sure we have the target component
-			for _, targetID := range e.To {
-				state.addedDict[targetID] = struct{}{}
-				if _, ok := state.componentsDict[targetID]; !ok {
-					return nil, fmt.Errorf("unable to locate node %s", targetID)
-				}
-
-				if state.componentsDict[e.From].Components == nil {
-					state.componentsDict[e.From].Components = &[]cdx.Component{}
-				}
-				*state.componentsDict[e.From].Components = append(*state.componentsDict[e.From].Components, *state.componentsDict[targetID])
+		for _, to := range e.To {
+			if !slices.Contains(*d.Dependencies, to) {
+				*d.Dependencies = append(*d.Dependencies, to)
 			}
+		}
+	}
+	return deps
+}
 
-		case sbom.Edge_dependsOn:
-			// Add to the dependency tree
-			targetStrings := []string{}
-			depListCheck := map[string]struct{}{}
-			for _, targetID := range e.To {
-				// Add entries to dependency only once.
-				if _, ok := depListCheck[targetID]; ok {
-					continue
-				}
-
-				if _, ok := state.componentsDict[targetID]; !ok {
-					return nil, fmt.Errorf("unable to locate node %s", targetID)
-				}
+// flattenDependencies computes the transitive closure of the dependency
+// graph. It returns a new list where each component depends directly on
+// all the components reachable from it. Cycles are broken by never visiting
+// a component twice and components are never listed as their own dependency.
+func flattenDependencies(deps []cdx.Dependency) []cdx.Dependency {
+	// Index the direct dependencies, merging entries with the same ref
+	refs := []string{}
+	direct := map[string][]string{}
+	for _, d := range deps {
+		if _, ok := direct[d.Ref]; !ok {
+			refs = append(refs, d.Ref)
+			direct[d.Ref] = []string{}
+		}
+		if d.Dependencies != nil {
+			direct[d.Ref] = append(direct[d.Ref], *d.Dependencies...)
+		}
+	}
 
-				state.addedDict[targetID] = struct{}{}
-				depListCheck[targetID] = struct{}{}
-				targetStrings = append(targetStrings, targetID)
+	flattened := []cdx.Dependency{}
+	for _, ref := range refs {
+		closure := []string{}
+		seen := map[string]struct{}{ref: {}}
+		queue := slices.Clone(direct[ref])
+		for len(queue) > 0 {
+			next := queue[0]
+			queue = queue[1:]
+			if _, ok := seen[next]; ok {
+				continue
 			}
-			dependencies = append(dependencies, cdx.Dependency{
-				Ref:          e.From,
-				Dependencies: &targetStrings,
-			})
-		default:
-			// TODO(degradation) here, we would document how relationships are lost
-			logrus.Warnf(
-				"node %s is related with %s to %d other nodes, data will be lost",
-				e.From, e.Type, len(e.To),
-			)
+			seen[next] = struct{}{}
+			closure = append(closure, next)
+			queue = append(queue, direct[next]...)
 		}
+		flattened = append(flattened, cdx.Dependency{
+			Ref:          ref,
+			Dependencies: &closure,
+		})
 	}
+	return flattened
+}
 
-	return dependencies, nil
+// sortDependencyTargets sorts the target refs of each dependency in place
+func sortDependencyTargets(deps []cdx.Dependency) {
+	for _, d := range deps {
+		if d.Dependencies != nil {
+			slices.Sort(*d.Dependencies)
+		}
+	}
 }
 
 // nodeToComponent converts a node in protobuf to a CycloneDX component
@@ -288,14 +1838,17 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 	}
 
 	c := &cdx.Component{
-		BOMRef:             n.Id,
-		Name:               n.Name,
-		Version:            n.Version,
-		Description:        n.Description,
-		Hashes:             &[]cdx.Hash{},
-		ExternalReferences: &[]cdx.ExternalReference{},
+		BOMRef:      n.Id,
+		Name:        n.Name,
+		Version:     n.Version,
+		Description: n.Description,
 	}
 
+	hashes := s.hashesToCDX(n.Hashes)
+	c.Hashes = &hashes
+	extRefs := s.externalReferencesToCDX(n.ExternalReferences)
+	c.ExternalReferences = &extRefs
+
 	if n.Type == sbom.Node_FILE {
 		c.Type = cdx.ComponentTypeFile
 	} else if len(n.PrimaryPurpose) > 0 {
@@ -303,107 +1856,186 @@ func (s *CDX) nodeToComponent(n *sbom.Node) *cdx.Component {
 		if err == nil {
 			c.Type = componentType
 		}
-		// TODO(degradation): Multiple PrimaryPurpose in protobom.Node, but
-		// cdx.Component only allows single Type so we are using the first
+		// Multiple PrimaryPurpose in protobom.Node, but cdx.Component only
+		// allows single Type so we are using the first. See componentDegradations.
 	}
 
+	// Empty values are skipped throughout, strict consumers reject empty
+	// strings where the spec expects a value or no field at all
+	//
+	// TODO(degradation): Licenses are written by ID, or as an expression
+	// when they are compound, see licensesToCDX. protobom does not model
+	// custom license texts, so there is nothing to define once and share
+	// between components (CDX license bom-refs only identify a license,
+	// components cannot reference one by it).
 	if n.Licenses != nil && len(n.Licenses) > 0 {
-		var licenseChoices []cdx.LicenseChoice
-		var licenses cdx.Licenses
-		for _, l := range n.Licenses {
-			licenseChoices = append(licenseChoices, cdx.LicenseChoice{
-				License: &cdx.License{
-					ID: l,
-				},
-			})
-		}
-
-		licenses = licenseChoices
-		c.Licenses = &licenses
+		c.Licenses = licensesToCDX(n.Licenses)
 	}
 
-	if n.Hashes != nil && len(n.Hashes) > 0 {
-		for algo, hash := range n.Hashes {
-			cdxAlgo, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(algo))
-			if err != nil {
-				// TODO(degradation): Algorithm not supported in CDX
+	// TODO(degradation): No identity evidence is written for the
+	// identifiers. See the note in the CDX unserializer.
+	if n.Identifiers != nil {
+		// Sort the identifier types to get the properties in a stable order
+		idTypes := []int32{}
+		for idType, value := range n.Identifiers {
+			if value == "" {
 				continue
 			}
-			*c.Hashes = append(*c.Hashes, cdx.Hash{
-				Algorithm: cdxAlgo,
-				Value:     hash,
-			})
+			idTypes = append(idTypes, idType)
 		}
-	}
-
-	if n.ExternalReferences != nil {
-		for _, er := range n.ExternalReferences {
-			cdxRef := cdx.ExternalReference{
-				URL:     er.Url,
-				Comment: er.Comment,
-				Type:    s.protobomExtRefTypeToCdxType(er.Type),
-			}
-			hashList := []cdx.Hash{}
-			for protoAlgo, val := range er.Hashes {
-				cdxAlgo, err := s.protoHashAlgoToCdxAlgo(sbom.HashAlgorithm(protoAlgo))
-				if err != nil {
-					// TODO(degradation): Hash not supported
-					continue
-				}
-				hashList = append(hashList, cdx.Hash{
-					Algorithm: cdxAlgo,
-					Value:     val,
-				})
+		slices.Sort(idTypes)
+
+		// Identifiers without a native CDX field are preserved as namespaced
+		// component properties. Types not defined in the enum are written
+		// with their raw key.
+		toProperty := func(idType int32) {
+			idName := strconv.Itoa(int(idType))
+			if name, ok := sbom.SoftwareIdentifierType_name[idType]; ok {
+				idName = strings.ToLower(name)
+			} else {
+				logrus.Warnf("node %s has an identifier of unknown type %d", n.Id, idType)
 			}
-			if len(hashList) > 0 {
-				cdxRef.Hashes = &hashList
+			if c.Properties == nil {
+				c.Properties = &[]cdx.Property{}
 			}
-			*c.ExternalReferences = append(*c.ExternalReferences, cdxRef)
+			*c.Properties = append(*c.Properties, cdx.Property{
+				Name:  cdxformats.PropertyIdentifierPrefix + idName,
+				Value: n.Identifiers[idType],
+			})
 		}
-	}
 
-	if n.Identifiers != nil {
-		for idType := range n.Identifiers {
+		for _, idType := range idTypes {
 			switch idType {
 			case int32(sbom.SoftwareIdentifierType_PURL):
 				c.PackageURL = n.Identifiers[idType]
 			case int32(sbom.SoftwareIdentifierType_CPE23):
 				c.CPE = n.Identifiers[idType]
 			case int32(sbom.SoftwareIdentifierType_CPE22):
-				// TODO(degradation): Only one CPE is supported in CDX
+				// Only one CPE is supported in CDX. The CPE 2.2 one can be
+				// kept as a property, see addCPE22Property.
 				if c.CPE == "" {
 					c.CPE = n.Identifiers[idType]
 				}
+			case int32(sbom.SoftwareIdentifierType_GITOID):
+				// CDX 1.6 added a native field for OmniBOR (gitoid) identifiers
+				if s.specVersion() < cdx.SpecVersion1_6 {
+					toProperty(idType)
+					continue
+				}
+				c.OmniborID = &[]string{n.Identifiers[idType]}
+			case int32(sbom.SoftwareIdentifierType_SWHID):
+				if s.specVersion() < cdx.SpecVersion1_6 {
+					toProperty(idType)
+					continue
+				}
+				c.SWHID = &[]string{n.Identifiers[idType]}
+			default:
+				toProperty(idType)
 			}
 		}
 	}
 
+	// CDX has a single purl field, additional ones are written as properties
+	if len(n.AlternatePurls) > 0 {
+		for _, purl := range n.AlternatePurls {
+			if purl == "" {
+				continue
+			}
+			if c.Properties == nil {
+				c.Properties = &[]cdx.Property{}
+			}
+			*c.Properties = append(*c.Properties, cdx.Property{
+				Name:  cdxformats.PropertyIdentifierPrefix + strings.ToLower(sbom.SoftwareIdentifierType_PURL.String()),
+				Value: purl,
+			})
+		}
+	}
+
+	// Node properties are written as is. The protobom namespace is reserved
+	// for the properties the serializer generates, so clashing names are
+	// dropped, except for the build metadata.
+	for _, p := range n.Properties {
+		if p.Name == "" {
+			continue
+		}
+		if strings.HasPrefix(p.Name, cdxformats.PropertyPrefix) &&
+			!strings.HasPrefix(p.Name, cdxformats.PropertyBuildPrefix) {
+			logrus.Warnf("node %s: dropping property %q, the %s namespace is reserved", n.Id, p.Name, cdxformats.PropertyPrefix)
+			continue
+		}
+		if c.Properties == nil {
+			c.Properties = &[]cdx.Property{}
+		}
+		*c.Properties = append(*c.Properties, cdx.Property{Name: p.Name, Value: p.Value})
+	}
+
+	if n.GetValidUntilDate() != nil {
+		if c.Properties == nil {
+			c.Properties = &[]cdx.Property{}
+		}
+		*c.Properties = append(*c.Properties, cdx.Property{
+			Name:  cdxformats.PropertyValidUntil,
+			Value: n.GetValidUntilDate().AsTime().UTC().Format(time.RFC3339),
+		})
+	}
+
+	// Evidence occurrences were added in CycloneDX 1.5 and their line,
+	// offset and symbol in 1.6. The encoder drops the fields the target
+	// version does not support.
+	if len(n.GetOccurrences()) > 0 && s.specVersion() >= cdx.SpecVersion1_5 {
+		occurrences := make([]cdx.EvidenceOccurrence, 0, len(n.GetOccurrences()))
+		for _, o := range n.GetOccurrences() {
+			occurrences = append(occurrences, occurrenceToCDX(o))
+		}
+		c.Evidence = &cdx.Evidence{Occurrences: &occurrences}
+	}
+
 	if n.Suppliers != nil && len(n.GetSuppliers()) > 0 {
-		// TODO(degradation): CDX type Component only supports one Supplier while protobom supports multiple
-
-		nodesupplier := n.GetSuppliers()[0]
-		oe := cdx.OrganizationalEntity{
-			Name: nodesupplier.GetName(),
-		}
-		if nodesupplier.Contacts != nil {
-			var contacts []cdx.OrganizationalContact
-			for _, nodecontact := range nodesupplier.GetContacts() {
-				newcontact := cdx.OrganizationalContact{
-					Name:  nodecontact.GetName(),
-					Email: nodecontact.GetEmail(),
-					Phone: nodecontact.GetPhone(),
-				}
-				contacts = append(contacts, newcontact)
+		// CDX type Component only supports one Supplier while protobom supports
+		// multiple. The rest are only written when configured, see addExtraSuppliers.
+		c.Supplier = personToOrganizationalEntity(n.GetSuppliers()[0])
+	}
+
+	// CycloneDX 1.6 added the component manufacturer. When targeting older
+	// versions the manufacturer is only used as the supplier if there is none.
+	if len(n.GetManufacturers()) > 0 {
+		// CDX type Component only supports one Manufacturer while protobom supports multiple
+		if s.specVersion() >= cdx.SpecVersion1_6 {
+			c.Manufacturer = personToOrganizationalEntity(n.GetManufacturers()[0])
+		} else if c.Supplier == nil {
+			c.Supplier = personToOrganizationalEntity(n.GetManufacturers()[0])
+		}
+	}
+
+	// The component authors list replaced the author string in CycloneDX 1.6
+	if len(n.GetOriginators()) > 0 {
+		if s.specVersion() >= cdx.SpecVersion1_6 {
+			authors := make([]cdx.OrganizationalContact, 0, len(n.GetOriginators()))
+			for _, p := range n.GetOriginators() {
+				authors = append(authors, cdx.OrganizationalContact{
+					Name:  p.GetName(),
+					Email: p.GetEmail(),
+					Phone: p.GetPhone(),
+				})
+			}
+			c.Authors = &authors
+		} else {
+			names := make([]string, 0, len(n.GetOriginators()))
+			for _, p := range n.GetOriginators() {
+				names = append(names, p.GetName())
 			}
-			oe.Contact = &contacts
+			c.Author = strings.Join(names, ", ")
 		}
-		c.Supplier = &oe
 	}
 
 	if n.GetCopyright() != "" {
 		c.Copyright = n.GetCopyright()
 	}
 
+	if n.ReleaseNotes != nil {
+		c.ReleaseNotes = s.releaseNotesToCDX(n.ReleaseNotes)
+	}
+
 	return c
 }
 
@@ -423,13 +2055,31 @@ func (s *CDX) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ i
 		return fmt.Errorf("getting CDX encoding: %w", err)
 	}
 
-	encoder := cdx.NewBOMEncoder(wr, encoding)
-	encoder.SetPretty(true)
-
 	if _, ok := doc.(*cdx.BOM); !ok {
 		return errors.New("document is not a cyclonedx bom")
 	}
 
+	pretty := o == nil || !o.Compact
+
+	// The encoder indents JSON with two spaces, other widths are applied
+	// by re-indenting its compact output
+	if pretty && o != nil && o.Indent > 0 && encoding == cdx.BOMFileFormatJSON {
+		var buf bytes.Buffer
+		if err := cdx.NewBOMEncoder(&buf, encoding).EncodeVersion(doc.(*cdx.BOM), version); err != nil {
+			return fmt.Errorf("encoding sbom to stream: %w", err)
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, buf.Bytes(), "", strings.Repeat(" ", o.Indent)); err != nil {
+			return fmt.Errorf("indenting sbom: %w", err)
+		}
+		if _, err := indented.WriteTo(wr); err != nil {
+			return fmt.Errorf("writing sbom to stream: %w", err)
+		}
+		return nil
+	}
+
+	encoder := cdx.NewBOMEncoder(wr, encoding)
+	encoder.SetPretty(pretty)
 	if err := encoder.EncodeVersion(doc.(*cdx.BOM), version); err != nil {
 		return fmt.Errorf("encoding sbom to stream: %w", err)
 	}
@@ -440,6 +2090,11 @@ func (s *CDX) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ i
 type serializerCDXState struct {
 	addedDict      map[string]struct{}
 	componentsDict map[string]*cdx.Component
+	// componentRefs records the order components were added to the
+	// dictionary to output them deterministically
+	componentRefs []string
+	// degradations lists the data dropped while building the document
+	degradations []native.Degradation
 }
 
 func newSerializerCDXState() *serializerCDXState {
@@ -449,13 +2104,18 @@ func newSerializerCDXState() *serializerCDXState {
 	}
 }
 
+// degrade records data that could not be written to the document
+func (s *serializerCDXState) degrade(d native.Degradation) {
+	s.degradations = append(s.degradations, d)
+}
+
 func (s *serializerCDXState) components() []cdx.Component {
 	components := []cdx.Component{}
-	for _, c := range s.componentsDict {
-		if _, ok := s.addedDict[c.BOMRef]; ok {
+	for _, ref := range s.componentRefs {
+		if _, ok := s.addedDict[ref]; ok {
 			continue
 		}
-		components = append(components, *c)
+		components = append(components, *s.componentsDict[ref])
 	}
 
 	return components
@@ -470,8 +2130,19 @@ func getCDXState(ctx context.Context) (*serializerCDXState, error) {
 }
 
 // protobomExtRefTypeToCdxType translates between the protobom external reference
-// identifiers and the CycloneDX equivalent types.
+// identifiers and the CycloneDX equivalent types. Unknown types, and types not
+// supported by the target spec version, are translated to other.
 func (s *CDX) protobomExtRefTypeToCdxType(protoExtRefType sbom.ExternalReference_ExternalReferenceType) cdx.ExternalReferenceType {
+	t := extRefTypeToCDX(protoExtRefType, s.specVersion())
+	if !cdxformats.ValidExternalReferenceType(t, s.specVersion()) {
+		return cdx.ERTypeOther
+	}
+	return t
+}
+
+// extRefTypeToCDX returns the CycloneDX type matching a protobom external
+// reference type, without checking it against the spec version.
+func extRefTypeToCDX(protoExtRefType sbom.ExternalReference_ExternalReferenceType, version cdx.SpecVersion) cdx.ExternalReferenceType {
 	switch protoExtRefType {
 	case sbom.ExternalReference_ATTESTATION:
 		return cdx.ERTypeAttestation
@@ -490,7 +2161,7 @@ func (s *CDX) protobomExtRefTypeToCdxType(protoExtRefType sbom.ExternalReference
 	case sbom.ExternalReference_COMPONENT_ANALYSIS_REPORT:
 		return cdx.ERTypeComponentAnalysisReport
 	case sbom.ExternalReference_CONFIGURATION:
-		return cdx.ExternalReferenceType("configuration")
+		return cdx.ERTypeConfiguration
 	case sbom.ExternalReference_DISTRIBUTION_INTAKE:
 		return cdx.ERTypeDistributionIntake
 	case sbom.ExternalReference_DOWNLOAD:
@@ -500,21 +2171,21 @@ func (s *CDX) protobomExtRefTypeToCdxType(protoExtRefType sbom.ExternalReference
 	case sbom.ExternalReference_DYNAMIC_ANALYSIS_REPORT:
 		return cdx.ERTypeDynamicAnalysisReport
 	case sbom.ExternalReference_EVIDENCE:
-		return cdx.ExternalReferenceType("evidence")
+		return cdx.ERTypeEvidence
 	case sbom.ExternalReference_FORMULATION:
-		return cdx.ExternalReferenceType("formulation")
+		return cdx.ERTypeFormulation
 	case sbom.ExternalReference_ISSUE_TRACKER:
 		return cdx.ERTypeIssueTracker
 	case sbom.ExternalReference_LICENSE:
 		return cdx.ERTypeLicense
 	case sbom.ExternalReference_LOG:
-		return cdx.ExternalReferenceType("log")
+		return cdx.ERTypeLog
 	case sbom.ExternalReference_MAILING_LIST:
 		return cdx.ERTypeMailingList
 	case sbom.ExternalReference_MATURITY_REPORT:
 		return cdx.ERTypeMaturityReport
 	case sbom.ExternalReference_MODEL_CARD:
-		return cdx.ExternalReferenceType("model-card")
+		return cdx.ERTypeModelCard
 	case sbom.ExternalReference_OTHER:
 		return cdx.ERTypeOther
 	case sbom.ExternalReference_POAM:
@@ -523,6 +2194,13 @@ func (s *CDX) protobomExtRefTypeToCdxType(protoExtRefType sbom.ExternalReference
 		return cdx.ERTypeQualityMetrics
 	case sbom.ExternalReference_RELEASE_NOTES:
 		return cdx.ERTypeReleaseNotes
+	case sbom.ExternalReference_RFC_9116:
+		// security.txt references were added in CycloneDX 1.6, older
+		// versions get the closest match.
+		if version < cdx.SpecVersion1_6 {
+			return cdx.ERTypeSecurityContact
+		}
+		return cdx.ExternalReferenceType("rfc-9116")
 	case sbom.ExternalReference_RISK_ASSESSMENT:
 		return cdx.ERTypeRiskAssessment
 	case sbom.ExternalReference_RUNTIME_ANALYSIS_REPORT:
@@ -557,10 +2235,9 @@ func (s *CDX) protobomExtRefTypeToCdxType(protoExtRefType sbom.ExternalReference
 }
 
 // protoHashAlgoToCdxAlgo converts the protobom algorithm to the CDX
-// algorithm string.
-// TODO(degradation): The use of the following algorithms will result in
-// data loss when rendering to CycloneDX 1.4: ADLER32 MD4 MD6 SHA224
-// Also, HashAlgorithm_UNKNOWN also means data loss.
+// algorithm string. The algorithms without a CDX equivalent (ADLER32, MD4,
+// MD6, SHA224 and UNKNOWN) return an error, the hashes using them are
+// reported as degradations, see unsupportedHashAlgorithms.
 func (s *CDX) protoHashAlgoToCdxAlgo(protoAlgo sbom.HashAlgorithm) (cdx.HashAlgorithm, error) {
 	switch protoAlgo {
 	case sbom.HashAlgorithm_MD5:
@@ -0,0 +1,48 @@
+package serializers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/bom-squad/protobom/pkg/native"
+	"sigs.k8s.io/release-utils/version"
+)
+
+// conversionAnnotation returns an annotation recording that protobom wrote
+// the document. The annotation text summarizes the degradations found while
+// serializing, one per line. The metadata component is used as the
+// annotation subject.
+func conversionAnnotation(doc *cdx.BOM, sourceFormat string, degradations []native.Degradation, ts time.Time) cdx.Annotation {
+	var text strings.Builder
+	text.WriteString("Document generated by protobom")
+	if sourceFormat != "" {
+		fmt.Fprintf(&text, " from %s", sourceFormat)
+	}
+	fmt.Fprintf(&text, " with %d degradations", len(degradations))
+	if len(degradations) > 0 {
+		text.WriteString(":")
+		for _, d := range degradations {
+			text.WriteString("\n- " + d.String())
+		}
+	}
+
+	annotation := cdx.Annotation{
+		Annotator: &cdx.Annotator{
+			Component: &cdx.Component{
+				Type:    cdx.ComponentTypeApplication,
+				Name:    generatorToolName,
+				Version: version.GetVersionInfo().GitVersion,
+			},
+		},
+		Timestamp: ts.Format(time.RFC3339),
+		Text:      text.String(),
+	}
+
+	if doc.Metadata != nil && doc.Metadata.Component != nil && doc.Metadata.Component.BOMRef != "" {
+		annotation.Subjects = &[]cdx.BOMReference{cdx.BOMReference(doc.Metadata.Component.BOMRef)}
+	}
+
+	return annotation
+}
@@ -0,0 +1,51 @@
+package serializers
+
+// CDXOptions are the CycloneDX specific options of the serializer. They are
+// passed to Serialize as its format options.
+type CDXOptions struct {
+	// Diagnostics is filled with the nesting decisions made while
+	// building the document when set. It is intended for debugging why
+	// a component is not listed where it was expected.
+	Diagnostics *CDXDiagnostics
+
+	// ConversionAnnotation adds an annotation to the document (CycloneDX
+	// 1.5 and later) stating that protobom produced it, which lists the
+	// data that could not be represented in the output.
+	ConversionAnnotation bool
+}
+
+// CDXDiagnostics records where the serializer placed each node of the
+// protobom in the CycloneDX document.
+type CDXDiagnostics struct {
+	// Root is the ID of the node written as the metadata component.
+	Root string
+
+	// Nested lists the IDs of the nodes nested as subcomponents of
+	// another component through a contains edge.
+	Nested []string
+
+	// TopLevel lists the IDs of the nodes written in the top level
+	// components list.
+	TopLevel []string
+}
+
+// diagnostics returns the placement of the nodes recorded in the state.
+// Nodes are listed in the order they were added to the components dictionary.
+func (s *serializerCDXState) diagnostics(root string) *CDXDiagnostics {
+	d := &CDXDiagnostics{
+		Root:     root,
+		Nested:   []string{},
+		TopLevel: []string{},
+	}
+	for _, ref := range s.componentRefs {
+		if ref == root {
+			continue
+		}
+		if _, ok := s.addedDict[ref]; ok {
+			d.Nested = append(d.Nested, ref)
+			continue
+		}
+		d.TopLevel = append(d.TopLevel, ref)
+	}
+	return d
+}
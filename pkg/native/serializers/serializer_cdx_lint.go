@@ -0,0 +1,252 @@
+package serializers
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+)
+
+// lintFinding records an invalid enumerated value found in a component
+// and the value it was replaced with.
+type lintFinding struct {
+	Ref   string
+	Field string
+	Value string
+	Fix   string
+}
+
+func (f lintFinding) String() string {
+	// Findings without a ref are in the document metadata
+	subject := "document"
+	if f.Ref != "" {
+		subject = fmt.Sprintf("component %q", f.Ref)
+	}
+	return subject + ": " + f.detail()
+}
+
+// detail describes the finding without its subject
+func (f lintFinding) detail() string {
+	if f.Fix == "" {
+		return fmt.Sprintf("invalid %s %q removed", f.Field, f.Value)
+	}
+	return fmt.Sprintf("invalid %s %q replaced with %q", f.Field, f.Value, f.Fix)
+}
+
+// degradation returns the finding as a degradation of its node
+func (f lintFinding) degradation() native.Degradation {
+	return native.Degradation{NodeID: f.Ref, Field: f.Field, Message: f.detail()}
+}
+
+// lintBOM checks the enumerated values of a CycloneDX document against the
+// values allowed by the target spec version before it is rendered. The
+// encoder writes any string it gets (or silently converts it), so invalid
+// values are corrected in place and reported back as findings:
+//
+//   - Unknown component types are replaced with library.
+//   - Unknown scopes are removed.
+//   - Hashes with unknown algorithms are removed.
+//   - Unknown external reference types are replaced with other.
+//   - Lifecycles with unknown phases are removed.
+func lintBOM(doc *cdx.BOM, enums cdxformats.Enums) []lintFinding {
+	findings := []lintFinding{}
+	if doc == nil {
+		return findings
+	}
+
+	findings = append(findings, lintLifecycles(doc.Metadata, enums)...)
+
+	if doc.Metadata != nil && doc.Metadata.Component != nil {
+		findings = append(findings, lintComponent(doc.Metadata.Component, enums)...)
+	}
+
+	if doc.Components != nil {
+		findings = append(findings, lintComponents(doc.Components, enums)...)
+	}
+
+	return findings
+}
+
+// lintLifecycles removes the lifecycles of the document metadata with a
+// phase unknown to the target spec version.
+func lintLifecycles(md *cdx.Metadata, enums cdxformats.Enums) []lintFinding {
+	findings := []lintFinding{}
+	if md == nil || md.Lifecycles == nil {
+		return findings
+	}
+
+	lifecycles := []cdx.Lifecycle{}
+	for _, lc := range *md.Lifecycles {
+		if lc.Phase != "" {
+			if _, ok := enums.LifecyclePhases[lc.Phase]; !ok {
+				findings = append(findings, lintFinding{Field: "lifecycle phase", Value: string(lc.Phase)})
+				continue
+			}
+		}
+		lifecycles = append(lifecycles, lc)
+	}
+	*md.Lifecycles = lifecycles
+	return findings
+}
+
+// lintComponents lints a list of components recursively
+func lintComponents(comps *[]cdx.Component, enums cdxformats.Enums) []lintFinding {
+	findings := []lintFinding{}
+	for i := range *comps {
+		findings = append(findings, lintComponent(&(*comps)[i], enums)...)
+	}
+	return findings
+}
+
+// lintComponent checks the enumerated values of a single component and
+// its subcomponents.
+func lintComponent(c *cdx.Component, enums cdxformats.Enums) []lintFinding {
+	findings := []lintFinding{}
+
+	// An empty type is left alone, it signals no purpose was known
+	if c.Type != "" {
+		if _, ok := enums.ComponentTypes[c.Type]; !ok {
+			findings = append(findings, lintFinding{
+				Ref: c.BOMRef, Field: "component type", Value: string(c.Type), Fix: string(cdx.ComponentTypeLibrary),
+			})
+			c.Type = cdx.ComponentTypeLibrary
+		}
+	}
+
+	if c.Scope != "" {
+		if _, ok := enums.Scopes[c.Scope]; !ok {
+			findings = append(findings, lintFinding{
+				Ref: c.BOMRef, Field: "scope", Value: string(c.Scope),
+			})
+			c.Scope = ""
+		}
+	}
+
+	if c.Hashes != nil {
+		hashes := []cdx.Hash{}
+		for _, h := range *c.Hashes {
+			if _, ok := enums.HashAlgorithms[h.Algorithm]; !ok {
+				findings = append(findings, lintFinding{
+					Ref: c.BOMRef, Field: "hash algorithm", Value: string(h.Algorithm),
+				})
+				continue
+			}
+			hashes = append(hashes, h)
+		}
+		*c.Hashes = hashes
+	}
+
+	if c.ExternalReferences != nil {
+		for i := range *c.ExternalReferences {
+			t := (*c.ExternalReferences)[i].Type
+			if _, ok := enums.ExternalReferenceTypes[t]; !ok {
+				findings = append(findings, lintFinding{
+					Ref: c.BOMRef, Field: "external reference type", Value: string(t), Fix: string(cdx.ERTypeOther),
+				})
+				(*c.ExternalReferences)[i].Type = cdx.ERTypeOther
+			}
+		}
+	}
+
+	if c.Components != nil {
+		findings = append(findings, lintComponents(c.Components, enums)...)
+	}
+
+	return findings
+}
+
+// sanitizeBOMStrings replaces the invalid UTF-8 sequences in the strings of
+// the document components with the Unicode replacement character. Each
+// cleaned string is returned as a finding.
+func sanitizeBOMStrings(doc *cdx.BOM) []lintFinding {
+	findings := []lintFinding{}
+	if doc == nil {
+		return findings
+	}
+
+	if doc.Metadata != nil && doc.Metadata.Component != nil {
+		findings = append(findings, sanitizeComponentStrings(doc.Metadata.Component)...)
+	}
+
+	if doc.Components != nil {
+		for i := range *doc.Components {
+			findings = append(findings, sanitizeComponentStrings(&(*doc.Components)[i])...)
+		}
+	}
+
+	return findings
+}
+
+// sanitizeComponentStrings cleans the free text fields of a component and
+// its subcomponents.
+func sanitizeComponentStrings(c *cdx.Component) []lintFinding {
+	findings := []lintFinding{}
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{"name", &c.Name},
+		{"version", &c.Version},
+		{"group", &c.Group},
+		{"description", &c.Description},
+		{"publisher", &c.Publisher},
+		{"copyright", &c.Copyright},
+	} {
+		if utf8.ValidString(*f.value) {
+			continue
+		}
+		fixed := strings.ToValidUTF8(*f.value, string(utf8.RuneError))
+		findings = append(findings, lintFinding{
+			Ref: c.BOMRef, Field: f.name + " (invalid UTF-8)", Value: *f.value, Fix: fixed,
+		})
+		*f.value = fixed
+	}
+
+	if c.Components != nil {
+		for i := range *c.Components {
+			findings = append(findings, sanitizeComponentStrings(&(*c.Components)[i])...)
+		}
+	}
+
+	return findings
+}
+
+// duplicateBOMRefs walks the metadata component and the component tree of
+// the document and returns the bom-refs found in more than one place, in
+// the order they were first seen. Components without a bom-ref are skipped.
+func duplicateBOMRefs(doc *cdx.BOM) []string {
+	dupes := []string{}
+	if doc == nil {
+		return dupes
+	}
+
+	seen := map[string]int{}
+	var walk func(c *cdx.Component)
+	walk = func(c *cdx.Component) {
+		if c.BOMRef != "" {
+			seen[c.BOMRef]++
+			if seen[c.BOMRef] == 2 {
+				dupes = append(dupes, c.BOMRef)
+			}
+		}
+		if c.Components != nil {
+			for i := range *c.Components {
+				walk(&(*c.Components)[i])
+			}
+		}
+	}
+
+	if doc.Metadata != nil && doc.Metadata.Component != nil {
+		walk(doc.Metadata.Component)
+	}
+	if doc.Components != nil {
+		for i := range *doc.Components {
+			walk(&(*doc.Components)[i])
+		}
+	}
+
+	return dupes
+}
@@ -0,0 +1,226 @@
+package serializers
+
+import (
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintBOM(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sut      cdx.Component
+		findings int
+		validate func(*testing.T, *cdx.Component)
+	}{
+		{
+			name: "valid component",
+			sut: cdx.Component{
+				BOMRef: "valid",
+				Type:   cdx.ComponentTypeLibrary,
+				Scope:  cdx.ScopeRequired,
+				Hashes: &[]cdx.Hash{{Algorithm: cdx.HashAlgoSHA256, Value: "abc"}},
+				ExternalReferences: &[]cdx.ExternalReference{
+					{Type: cdx.ERTypeWebsite, URL: "https://example.com/"},
+				},
+			},
+			validate: func(t *testing.T, c *cdx.Component) {
+				require.Equal(t, cdx.ComponentTypeLibrary, c.Type)
+				require.Len(t, *c.Hashes, 1)
+			},
+		},
+		{
+			name: "invalid component type",
+			sut:  cdx.Component{BOMRef: "comp", Type: cdx.ComponentType("gadget")},
+			validate: func(t *testing.T, c *cdx.Component) {
+				require.Equal(t, cdx.ComponentTypeLibrary, c.Type)
+			},
+			findings: 1,
+		},
+		{
+			name: "invalid scope",
+			sut:  cdx.Component{BOMRef: "comp", Type: cdx.ComponentTypeLibrary, Scope: cdx.Scope("sometimes")},
+			validate: func(t *testing.T, c *cdx.Component) {
+				require.Empty(t, c.Scope)
+			},
+			findings: 1,
+		},
+		{
+			name: "invalid hash algorithm",
+			sut: cdx.Component{
+				BOMRef: "comp",
+				Type:   cdx.ComponentTypeLibrary,
+				Hashes: &[]cdx.Hash{
+					{Algorithm: cdx.HashAlgoSHA1, Value: "abc"},
+					{Algorithm: cdx.HashAlgorithm("CRC32"), Value: "def"},
+				},
+			},
+			validate: func(t *testing.T, c *cdx.Component) {
+				require.Len(t, *c.Hashes, 1)
+				require.Equal(t, cdx.HashAlgoSHA1, (*c.Hashes)[0].Algorithm)
+			},
+			findings: 1,
+		},
+		{
+			name: "invalid external reference type in subcomponent",
+			sut: cdx.Component{
+				BOMRef: "comp",
+				Type:   cdx.ComponentTypeLibrary,
+				Components: &[]cdx.Component{
+					{
+						BOMRef: "sub",
+						Type:   cdx.ComponentTypeFile,
+						ExternalReferences: &[]cdx.ExternalReference{
+							{Type: cdx.ExternalReferenceType("homepage"), URL: "https://example.com/"},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *cdx.Component) {
+				require.Equal(t, cdx.ERTypeOther, (*(*c.Components)[0].ExternalReferences)[0].Type)
+			},
+			findings: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := cdx.NewBOM()
+			doc.Components = &[]cdx.Component{tc.sut}
+			findings := lintBOM(doc, cdxformats.ValidEnums(cdx.SpecVersion1_6))
+			require.Len(t, findings, tc.findings)
+			tc.validate(t, &(*doc.Components)[0])
+		})
+	}
+}
+
+func TestLintBOMSpecVersion(t *testing.T) {
+	newDoc := func() *cdx.BOM {
+		doc := cdx.NewBOM()
+		doc.Metadata = &cdx.Metadata{
+			Lifecycles: &[]cdx.Lifecycle{{Phase: cdx.LifecyclePhaseBuild}, {Name: "custom"}},
+			Component:  &cdx.Component{BOMRef: "root", Type: cdx.ComponentTypeCryptographicAsset},
+		}
+		doc.Components = &[]cdx.Component{
+			{
+				BOMRef: "comp",
+				Type:   cdx.ComponentTypePlatform,
+				ExternalReferences: &[]cdx.ExternalReference{
+					{Type: cdx.ExternalReferenceType("rfc-9116"), URL: "https://example.com/.well-known/security.txt"},
+					{Type: cdx.ERTypeWebsite, URL: "https://example.com/"},
+				},
+			},
+		}
+		return doc
+	}
+
+	for _, tc := range []struct {
+		version  cdx.SpecVersion
+		findings int
+		validate func(*testing.T, *cdx.BOM)
+	}{
+		{
+			version: cdx.SpecVersion1_6,
+			validate: func(t *testing.T, doc *cdx.BOM) {
+				require.Len(t, *doc.Metadata.Lifecycles, 2)
+				require.Equal(t, cdx.ComponentTypeCryptographicAsset, doc.Metadata.Component.Type)
+			},
+		},
+		{
+			version:  cdx.SpecVersion1_5,
+			findings: 2,
+			validate: func(t *testing.T, doc *cdx.BOM) {
+				require.Equal(t, cdx.ComponentTypeLibrary, doc.Metadata.Component.Type)
+				require.Equal(t, cdx.ComponentTypePlatform, (*doc.Components)[0].Type)
+				require.Equal(t, cdx.ERTypeOther, (*(*doc.Components)[0].ExternalReferences)[0].Type)
+			},
+		},
+		{
+			version:  cdx.SpecVersion1_4,
+			findings: 4,
+			validate: func(t *testing.T, doc *cdx.BOM) {
+				require.Equal(t, []cdx.Lifecycle{{Name: "custom"}}, *doc.Metadata.Lifecycles)
+				require.Equal(t, cdx.ComponentTypeLibrary, (*doc.Components)[0].Type)
+				require.Equal(t, cdx.ERTypeOther, (*(*doc.Components)[0].ExternalReferences)[0].Type)
+				require.Equal(t, cdx.ERTypeWebsite, (*(*doc.Components)[0].ExternalReferences)[1].Type)
+			},
+		},
+	} {
+		t.Run(tc.version.String(), func(t *testing.T) {
+			doc := newDoc()
+			findings := lintBOM(doc, cdxformats.ValidEnums(tc.version))
+			require.Len(t, findings, tc.findings)
+			tc.validate(t, doc)
+		})
+	}
+}
+
+func TestSanitizeBOMStrings(t *testing.T) {
+	doc := cdx.NewBOM()
+	doc.Metadata = &cdx.Metadata{
+		Component: &cdx.Component{
+			BOMRef: "root",
+			Name:   "app",
+			Components: &[]cdx.Component{
+				{BOMRef: "sub", Name: "lib\xffname", Description: "valid ✓"},
+			},
+		},
+	}
+	doc.Components = &[]cdx.Component{
+		{BOMRef: "comp", Name: "comp", Description: "bad\xc3\x28text"},
+	}
+
+	findings := sanitizeBOMStrings(doc)
+	require.Len(t, findings, 2)
+	require.Equal(t, "sub", findings[0].Ref)
+	require.Equal(t, "lib�name", (*doc.Metadata.Component.Components)[0].Name)
+	require.Equal(t, "valid ✓", (*doc.Metadata.Component.Components)[0].Description)
+	require.Equal(t, "comp", findings[1].Ref)
+	require.Equal(t, "bad�(text", (*doc.Components)[0].Description)
+}
+
+func TestDuplicateBOMRefs(t *testing.T) {
+	doc := cdx.NewBOM()
+	doc.Metadata = &cdx.Metadata{
+		Component: &cdx.Component{BOMRef: "root", Name: "app"},
+	}
+	doc.Components = &[]cdx.Component{
+		{
+			BOMRef: "parent",
+			Components: &[]cdx.Component{
+				{BOMRef: "nested"},
+				{BOMRef: "other"},
+			},
+		},
+		{BOMRef: "nested"},
+		{Name: "group without ref"},
+		{Name: "another group without ref"},
+	}
+	require.Equal(t, []string{"nested"}, duplicateBOMRefs(doc))
+
+	(*doc.Components)[1].BOMRef = "unique"
+	require.Empty(t, duplicateBOMRefs(doc))
+	require.Empty(t, duplicateBOMRefs(nil))
+}
+
+func TestSerializeDuplicateBOMRefs(t *testing.T) {
+	// A containment cycle back to the root nests the components under
+	// the root and under the last component of the cycle
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"lib", "util"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id, PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib", To: []string{"util"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "util", To: []string{"app"}})
+
+	degradations := []native.Degradation{}
+	_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{Degradations: &degradations}, nil)
+	require.NoError(t, err)
+	require.Contains(t, degradations, native.Degradation{
+		NodeID: "app", Field: "bom-ref", Message: "component appears more than once in the document",
+	})
+}
@@ -0,0 +1,96 @@
+package serializers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/sirupsen/logrus"
+)
+
+// purlHashRef returns the identifier of a node computed from the SHA-256
+// digest of its purl and primary hash. It returns an empty string if the
+// node has neither of them.
+func purlHashRef(n *sbom.Node) string {
+	purl := string(n.Purl())
+	hash := primaryHash(n)
+	if purl == "" && hash == "" {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(purl+hash)))
+}
+
+// primaryHash returns the hash value used to identify a node. The SHA-256
+// hash is preferred, otherwise the hash with the lowest algorithm is used.
+func primaryHash(n *sbom.Node) string {
+	if h, ok := n.Hashes[int32(sbom.HashAlgorithm_SHA256)]; ok {
+		return h
+	}
+
+	algos := make([]int32, 0, len(n.Hashes))
+	for algo := range n.Hashes {
+		algos = append(algos, algo)
+	}
+	if len(algos) == 0 {
+		return ""
+	}
+	sort.Slice(algos, func(i, j int) bool { return algos[i] < algos[j] })
+	return n.Hashes[algos[0]]
+}
+
+// withPurlHashRefs returns a document with the node IDs replaced by the
+// identifiers derived from their purl and hash. Edges and root elements are
+// rewritten to match. Nodes without purl and hashes and nodes whose
+// identifier would collide with another node keep their original ID.
+// The original document is not modified.
+func withPurlHashRefs(bom *sbom.Document) *sbom.Document {
+	nl := bom.NodeList.Copy()
+
+	refs := map[string]string{}
+	used := map[string]struct{}{}
+	for _, n := range nl.Nodes {
+		used[n.Id] = struct{}{}
+	}
+
+	for _, n := range nl.Nodes {
+		ref := purlHashRef(n)
+		if ref == "" || ref == n.Id {
+			continue
+		}
+		if _, ok := used[ref]; ok {
+			logrus.Warnf("node %s identifier %s is already in use, keeping its ID", n.Id, ref)
+			continue
+		}
+		used[ref] = struct{}{}
+		refs[n.Id] = ref
+	}
+
+	rebase := func(id string) string {
+		if ref, ok := refs[id]; ok {
+			return ref
+		}
+		return id
+	}
+
+	for _, n := range nl.Nodes {
+		n.Id = rebase(n.Id)
+	}
+	for _, e := range nl.Edges {
+		// Edge copies share their targets with the original
+		to := make([]string, 0, len(e.To))
+		for _, id := range e.To {
+			to = append(to, rebase(id))
+		}
+		e.From = rebase(e.From)
+		e.To = to
+	}
+	for i := range nl.RootElements {
+		nl.RootElements[i] = rebase(nl.RootElements[i])
+	}
+
+	return &sbom.Document{
+		Metadata: bom.Metadata,
+		NodeList: nl,
+	}
+}
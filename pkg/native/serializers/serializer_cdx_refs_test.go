@@ -0,0 +1,97 @@
+package serializers
+
+import (
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurlHashRefs(t *testing.T) {
+	// buildDoc returns the same document with different node IDs
+	buildDoc := func(ids []string) *sbom.Document {
+		doc := sbom.NewDocument()
+		doc.NodeList.AddRootNode(&sbom.Node{
+			Id: ids[0], Name: "app", Version: "1.0.0",
+			Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/app@1.0.0"},
+		})
+		doc.NodeList.AddNode(&sbom.Node{
+			Id: ids[1], Name: "lib1", Version: "2.0.0",
+			Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:npm/lib1@2.0.0"},
+			Hashes: map[int32]string{
+				int32(sbom.HashAlgorithm_SHA1):   "e6b1000b94e835ffd37f4c6dcbdad43f4b48a02a",
+				int32(sbom.HashAlgorithm_SHA256): "f498a8ff2dd007e29c2074f5e4b01a9a01775c3ff3aeaf6906ea503bc5791b7b",
+			},
+		})
+		doc.NodeList.AddNode(&sbom.Node{
+			Id: ids[2], Name: "lib2", Version: "3.0.0",
+			Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA1): "3942447fac867ae5cdb3229b658f4d48a4a0a3c4"},
+		})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: ids[0], To: []string{ids[1], ids[2]}})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: ids[1], To: []string{ids[2]}})
+		return doc
+	}
+
+	serialize := func(doc *sbom.Document) *cdx.BOM {
+		sut := NewCDX("1.5", "json")
+		bom, err := sut.Serialize(doc, &native.SerializeOptions{BOMRefStrategy: native.BOMRefPurlHash}, nil)
+		require.NoError(t, err)
+		return bom.(*cdx.BOM)
+	}
+
+	doc1 := buildDoc([]string{"protobom-auto--000000001", "protobom-auto--000000002", "protobom-auto--000000003"})
+	doc2 := buildDoc([]string{"root", "pkg:npm/lib1@2.0.0", "SPDXRef-Package-lib2"})
+	bom1 := serialize(doc1)
+	bom2 := serialize(doc2)
+
+	// The original documents are not modified
+	require.Equal(t, "protobom-auto--000000001", doc1.NodeList.RootElements[0])
+	require.Equal(t, []string{"protobom-auto--000000002", "protobom-auto--000000003"}, doc1.NodeList.Edges[0].To)
+
+	require.Equal(t, bom1.Metadata.Component.BOMRef, bom2.Metadata.Component.BOMRef)
+	require.Len(t, *bom1.Metadata.Component.Components, 2)
+	for i, c := range *bom1.Metadata.Component.Components {
+		require.NotEmpty(t, c.BOMRef)
+		require.Equal(t, c.BOMRef, (*bom2.Metadata.Component.Components)[i].BOMRef)
+	}
+	require.Equal(t, *bom1.Dependencies, *bom2.Dependencies)
+
+	// Dependencies point to the rewritten refs
+	lib1 := (*bom1.Metadata.Component.Components)[0]
+	lib2 := (*bom1.Metadata.Component.Components)[1]
+	require.Len(t, *bom1.Dependencies, 1)
+	require.Equal(t, lib1.BOMRef, (*bom1.Dependencies)[0].Ref)
+	require.Equal(t, []string{lib2.BOMRef}, *(*bom1.Dependencies)[0].Dependencies)
+}
+
+func TestPrimaryHash(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		hashes   map[int32]string
+		expected string
+	}{
+		{name: "no hashes", hashes: nil, expected: ""},
+		{
+			name: "sha256 preferred",
+			hashes: map[int32]string{
+				int32(sbom.HashAlgorithm_MD5):    "md5",
+				int32(sbom.HashAlgorithm_SHA256): "sha256",
+			},
+			expected: "sha256",
+		},
+		{
+			name: "lowest algorithm",
+			hashes: map[int32]string{
+				int32(sbom.HashAlgorithm_SHA512): "sha512",
+				int32(sbom.HashAlgorithm_SHA1):   "sha1",
+			},
+			expected: "sha1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, primaryHash(&sbom.Node{Hashes: tc.hashes}))
+		})
+	}
+}
@@ -0,0 +1,497 @@
+package serializers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/sirupsen/logrus"
+)
+
+// streamBatchSize is the number of components, or dependencies, that
+// SerializeStream encodes at a time.
+const streamBatchSize = 1000
+
+// SerializeStream writes the document to wr as CycloneDX JSON without
+// building the whole cdx.BOM in memory. The components are converted and
+// encoded in batches as the node list is walked, so the memory used by the
+// serializer stays flat regardless of the number of nodes.
+//
+// The output differs from Serialize in one way: the components contained
+// by the root are written at the top level instead of being nested under
+// metadata.component, which readers relate back to the root. The options
+// that need the whole document at once (pruning, truncation, purl hash
+// refs, grouping, contains dependencies, flattening and the components
+// digest) are not supported and return an error.
+func (s *CDX) SerializeStream(
+	ctx context.Context, bom *sbom.Document, wr io.Writer, opts *native.SerializeOptions,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if bom == nil || bom.Metadata == nil || bom.NodeList == nil {
+		return errors.New("unable to stream cyclonedx, document is incomplete")
+	}
+
+	encoding, err := cdxformats.ParseEncoding(s.encoding)
+	if err != nil {
+		return fmt.Errorf("getting CDX encoding: %w", err)
+	}
+	if encoding != cdx.BOMFileFormatJSON {
+		return fmt.Errorf("streaming is only supported for the JSON encoding")
+	}
+
+	if err := checkStreamOptions(opts); err != nil {
+		return err
+	}
+
+	bom, merged, err := opts.CheckDuplicateIDs(bom)
+	if err != nil {
+		return fmt.Errorf("unable to stream cyclonedx: %w", err)
+	}
+	if merged > 0 {
+		logrus.Warnf("merged %d nodes with duplicate IDs", merged)
+	}
+
+	if l := len(bom.NodeList.RootElements); l > 1 {
+		return fmt.Errorf("unable to stream multiroot cyclonedx, document has %d root nodes", l)
+	} else if l == 0 && len(bom.NodeList.Nodes) > 0 {
+		return fmt.Errorf("unable to build cyclonedx document, no root nodes found")
+	}
+
+	g := newStreamGraph(bom.NodeList, opts)
+	sw := &streamWriter{s: s, wr: wr, enums: cdxformats.ValidEnums(s.specVersion())}
+
+	if err := s.streamHeader(sw, g, bom, opts); err != nil {
+		return err
+	}
+
+	// Top level components first, then the nodes nested under a parent
+	// that was never written (eg contains cycles)
+	if err := sw.open("components"); err != nil {
+		return err
+	}
+	batch := []cdx.Component{}
+	for _, pass := range []int{1, 2} {
+		for i, n := range bom.NodeList.Nodes {
+			if i%ctxCheckInterval == 0 {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+			}
+			if g.isRoot(n.Id) {
+				continue
+			}
+			written, nested := g.nested[n.Id]
+			if (pass == 1 && nested) || (pass == 2 && (!nested || written)) {
+				continue
+			}
+			c, err := s.streamComponent(g, n, opts)
+			if err != nil {
+				return err
+			}
+			if err := finishComponent(c, opts); err != nil {
+				return err
+			}
+			g.degradations = append(g.degradations, sw.lint(c)...)
+			cdxformats.RebaseComponentProperties(c, cdxformats.PropertyPrefix, propertyPrefix(opts))
+			batch = append(batch, *c)
+			if len(batch) == streamBatchSize {
+				if err := sw.components(batch); err != nil {
+					return err
+				}
+				batch = batch[:0]
+			}
+		}
+	}
+	if err := sw.components(batch); err != nil {
+		return err
+	}
+
+	if err := sw.open("dependencies"); err != nil {
+		return err
+	}
+	deps := []cdx.Dependency{}
+	for i, n := range bom.NodeList.Nodes {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		targets := g.dependencies(n.Id)
+		if len(targets) == 0 {
+			continue
+		}
+		if opts != nil && opts.SortDependencies {
+			slices.Sort(targets)
+		}
+		deps = append(deps, cdx.Dependency{Ref: n.Id, Dependencies: &targets})
+		if len(deps) == streamBatchSize {
+			if err := sw.dependencies(deps); err != nil {
+				return err
+			}
+			deps = deps[:0]
+		}
+	}
+	if sw.count == 0 && g.root != nil {
+		deps = withRootDependency(deps, g.root.Id)
+	}
+	if err := sw.dependencies(deps); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(wr, "]}\n"); err != nil {
+		return err
+	}
+
+	opts.CollectDegradations(g.degradations)
+	return nil
+}
+
+// checkStreamOptions returns an error if the options require processing
+// the whole document, which the streaming serializer cannot do.
+func checkStreamOptions(opts *native.SerializeOptions) error {
+	if opts == nil {
+		return nil
+	}
+	for name, set := range map[string]bool{
+		"PruneUnreachable":      opts.PruneUnreachable,
+		"MaxComponents":         opts.MaxComponents > 0,
+		"BOMRefStrategy":        opts.BOMRefStrategy != native.BOMRefNodeID,
+		"GroupComponentsByType": opts.GroupComponentsByType,
+		"ContainsDependencies":  opts.ContainsDependencies,
+		"FlattenDependencies":   opts.FlattenDependencies,
+		"ComponentsSHA256":      opts.ComponentsSHA256,
+	} {
+		if set {
+			return fmt.Errorf("option %s is not supported when streaming", name)
+		}
+	}
+	if opts.ExtraSuppliers != native.ExtraSuppliersDrop && opts.ExtraSuppliers != native.ExtraSuppliersProperties {
+		return fmt.Errorf("unknown extra suppliers strategy %q", opts.ExtraSuppliers)
+	}
+	return nil
+}
+
+// streamHeader writes the document up to the metadata, leaving the JSON
+// object open for the components and dependencies.
+func (s *CDX) streamHeader(sw *streamWriter, g *streamGraph, bom *sbom.Document, opts *native.SerializeOptions) error {
+	header := cdx.NewBOM()
+	if err := setDocumentIdentity(header, bom.Metadata, opts); err != nil {
+		return err
+	}
+	s.setDocumentExternalReferences(header, bom.Metadata, opts)
+	g.degradations = append(g.degradations, s.metadataDegradations(bom.Metadata)...)
+
+	metadata := &cdx.Metadata{Lifecycles: &[]cdx.Lifecycle{}}
+	if date := opts.DocumentDate(bom.Metadata); date != nil {
+		metadata.Timestamp = date.UTC().Format(time.RFC3339)
+	}
+	if err := s.fillMetadata(metadata, bom, opts); err != nil {
+		return err
+	}
+	header.Metadata = metadata
+
+	if g.root != nil {
+		// The root is written alone, its components go to the top level
+		root, err := s.streamComponent(g, g.root, opts)
+		if err != nil {
+			return err
+		}
+		if bom.GetMetadata().GetName() != "" {
+			root.Name = bom.GetMetadata().GetName()
+		}
+		if err := finishComponent(root, opts); err != nil {
+			return err
+		}
+		g.degradations = append(g.degradations, sw.lint(root)...)
+		metadata.Component = root
+	}
+	g.degradations = append(g.degradations, sw.lintMetadata(metadata)...)
+	cdxformats.RebaseProperties(header, cdxformats.PropertyPrefix, propertyPrefix(opts))
+
+	data, err := sw.encode(header)
+	if err != nil {
+		return fmt.Errorf("encoding document header: %w", err)
+	}
+
+	// Drop the closing brace to append the lists
+	data = bytes.TrimRight(data, "\n")
+	_, err = sw.wr.Write(data[:len(data)-1])
+	return err
+}
+
+// streamComponent converts a node to a component, nesting the components
+// of the edges configured as nested. Components nested under the root are
+// not added, they are written at the top level.
+func (s *CDX) streamComponent(g *streamGraph, n *sbom.Node, opts *native.SerializeOptions) (*cdx.Component, error) {
+	if _, ok := g.nested[n.Id]; ok {
+		g.nested[n.Id] = true
+	}
+
+	c := s.nodeToComponent(n)
+	if err := applyComponentOptions(c, n, opts); err != nil {
+		return nil, err
+	}
+	g.degradations = append(g.degradations, s.componentDegradations(c, n, opts)...)
+	if s.specVersion() >= cdx.SpecVersion1_2 {
+		for _, patch := range g.patches[n.Id] {
+			addPatch(c, patchToCDX(patch))
+		}
+	}
+
+	for _, e := range g.edges[n.Id] {
+		switch opts.EdgeRepresentation(e.Type) {
+		case native.EdgeNested:
+			if g.isRoot(n.Id) {
+				continue
+			}
+			for _, targetID := range e.To {
+				if g.nested[targetID] || g.isRoot(targetID) {
+					continue
+				}
+				target, ok := g.nodes[targetID]
+				if !ok {
+					return nil, fmt.Errorf("unable to locate node %s", targetID)
+				}
+				sub, err := s.streamComponent(g, target, opts)
+				if err != nil {
+					return nil, err
+				}
+				if c.Components == nil {
+					c.Components = &[]cdx.Component{}
+				}
+				*c.Components = append(*c.Components, *sub)
+			}
+		case native.EdgeProperty:
+			for _, targetID := range e.To {
+				if c.Properties == nil {
+					c.Properties = &[]cdx.Property{}
+				}
+				*c.Properties = append(*c.Properties, cdx.Property{
+					Name: cdxformats.PropertyEdgePrefix + e.Type.String(), Value: targetID,
+				})
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// streamGraph indexes the edges of the node list for the streaming
+// serializer. Only the nodes nested under a node other than the root are
+// indexed and tracked, the rest are written in the order of the node list.
+type streamGraph struct {
+	root  *sbom.Node
+	edges map[string][]*sbom.Edge
+	// nodes indexes the nested nodes by ID
+	nodes map[string]*sbom.Node
+	// nested records if each nested node has been written
+	nested map[string]bool
+	// patches indexes the nodes of the patches applied to each node
+	patches map[string][]*sbom.Node
+	// degradations records the data that could not be written
+	degradations []native.Degradation
+	opts         *native.SerializeOptions
+}
+
+func newStreamGraph(nl *sbom.NodeList, opts *native.SerializeOptions) *streamGraph {
+	g := &streamGraph{
+		edges:   map[string][]*sbom.Edge{},
+		nodes:   map[string]*sbom.Node{},
+		nested:  map[string]bool{},
+		patches: map[string][]*sbom.Node{},
+		opts:    opts,
+	}
+
+	rootID := ""
+	if len(nl.RootElements) > 0 {
+		rootID = nl.RootElements[0]
+	}
+	patched := map[string][]string{}
+	for _, e := range nl.Edges {
+		g.edges[e.From] = append(g.edges[e.From], e)
+		if opts.EdgeRepresentation(e.Type) == native.EdgeDropped {
+			logrus.Warnf(
+				"node %s is related with %s to %d other nodes, data will be lost",
+				e.From, e.Type, len(e.To),
+			)
+			g.degradations = append(g.degradations, native.Degradation{
+				NodeID:  e.From,
+				Field:   "relationships",
+				Message: fmt.Sprintf("%s relationships to %d nodes dropped", e.Type, len(e.To)),
+			})
+		}
+		if e.Type == sbom.Edge_patch && opts.EdgeRepresentation(e.Type) != native.EdgeDropped {
+			patched[e.From] = append(patched[e.From], e.To...)
+		}
+		if e.From == rootID || opts.EdgeRepresentation(e.Type) != native.EdgeNested {
+			continue
+		}
+		for _, to := range e.To {
+			g.nested[to] = false
+		}
+	}
+
+	for _, n := range nl.Nodes {
+		if n.Id == rootID {
+			g.root = n
+		}
+		if _, ok := g.nested[n.Id]; ok {
+			g.nodes[n.Id] = n
+		}
+		for _, targetID := range patched[n.Id] {
+			g.patches[targetID] = append(g.patches[targetID], n)
+		}
+	}
+	return g
+}
+
+// isRoot returns true if id is the ID of the root node
+func (g *streamGraph) isRoot(id string) bool {
+	return g.root != nil && g.root.Id == id
+}
+
+// dependencies returns the targets of the edges of a node written as
+// dependencies, without repeating them. With RootDependencies set, the
+// children of the root are included, see withRootChildren.
+func (g *streamGraph) dependencies(id string) []string {
+	rootChildren := g.opts != nil && g.opts.RootDependencies && g.isRoot(id)
+	targets := []string{}
+	seen := map[string]struct{}{}
+	for _, e := range g.edges[id] {
+		rep := g.opts.EdgeRepresentation(e.Type)
+		if rep != native.EdgeDependency && (rep != native.EdgeNested || !rootChildren) {
+			continue
+		}
+		for _, to := range e.To {
+			if _, ok := seen[to]; ok {
+				continue
+			}
+			seen[to] = struct{}{}
+			targets = append(targets, to)
+		}
+	}
+	return targets
+}
+
+// streamWriter writes the lists of the streamed document. Each batch is
+// encoded through the cyclonedx-go encoder, which converts the data to the
+// target spec version, and its elements are copied to the output.
+type streamWriter struct {
+	s     *CDX
+	wr    io.Writer
+	enums cdxformats.Enums
+	// count is the number of elements written to the current list
+	count int
+}
+
+// open closes the list being written, if any, and opens a new one
+func (sw *streamWriter) open(name string) error {
+	closing := ""
+	if name != "components" {
+		closing = "]"
+	}
+	sw.count = 0
+	_, err := fmt.Fprintf(sw.wr, "%s,%q:[", closing, name)
+	return err
+}
+
+// lint corrects the strings and enumerated values of a component for the
+// target spec version and returns the degradations of the changes made
+func (sw *streamWriter) lint(c *cdx.Component) []native.Degradation {
+	findings := sanitizeComponentStrings(c)
+	findings = append(findings, lintComponent(c, sw.enums)...)
+	return sw.report(findings)
+}
+
+// lintMetadata removes the lifecycles of the header metadata that the
+// target spec version does not allow, as lintBOM does for whole documents
+func (sw *streamWriter) lintMetadata(md *cdx.Metadata) []native.Degradation {
+	return sw.report(lintLifecycles(md, sw.enums))
+}
+
+// report logs lint findings and returns them as degradations
+func (sw *streamWriter) report(findings []lintFinding) []native.Degradation {
+	ret := []native.Degradation{}
+	for _, f := range findings {
+		logrus.Warnf("cyclonedx lint: %s", f)
+		ret = append(ret, f.degradation())
+	}
+	return ret
+}
+
+func (sw *streamWriter) components(batch []cdx.Component) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	clearAutoRefs(&batch)
+	doc := cdx.NewBOM()
+	doc.Components = &batch
+	return sw.writeList(doc, func(data []byte) ([]json.RawMessage, error) {
+		list := struct {
+			Components []json.RawMessage `json:"components"`
+		}{}
+		err := json.Unmarshal(data, &list)
+		return list.Components, err
+	})
+}
+
+func (sw *streamWriter) dependencies(batch []cdx.Dependency) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	doc := cdx.NewBOM()
+	doc.Dependencies = &batch
+	return sw.writeList(doc, func(data []byte) ([]json.RawMessage, error) {
+		list := struct {
+			Dependencies []json.RawMessage `json:"dependencies"`
+		}{}
+		err := json.Unmarshal(data, &list)
+		return list.Dependencies, err
+	})
+}
+
+// writeList encodes a document holding a batch of elements and appends the
+// elements extracted from the encoded data to the list being written.
+func (sw *streamWriter) writeList(doc *cdx.BOM, extract func([]byte) ([]json.RawMessage, error)) error {
+	data, err := sw.encode(doc)
+	if err != nil {
+		return fmt.Errorf("encoding batch: %w", err)
+	}
+	elements, err := extract(data)
+	if err != nil {
+		return fmt.Errorf("reading encoded batch: %w", err)
+	}
+	for _, e := range elements {
+		if sw.count > 0 {
+			if _, err := io.WriteString(sw.wr, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := sw.wr.Write(e); err != nil {
+			return err
+		}
+		sw.count++
+	}
+	return nil
+}
+
+// encode renders a document in compact JSON for the target spec version
+func (sw *streamWriter) encode(doc *cdx.BOM) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := cdx.NewBOMEncoder(&buf, cdx.BOMFileFormatJSON)
+	if err := encoder.EncodeVersion(doc, sw.s.specVersion()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
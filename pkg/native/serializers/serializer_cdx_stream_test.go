@@ -0,0 +1,218 @@
+package serializers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/metrics"
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializeStream(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.Metadata.Version = "2"
+	doc.Metadata.Authors = []*sbom.Person{{Name: "John Doe"}}
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+	for _, id := range []string{"lib1", "lib2", "file1", "tool"} {
+		doc.NodeList.AddNode(&sbom.Node{
+			Id: id, Name: id, Version: "1.0.0",
+			PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2", "tool"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2", "lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_buildTool, From: "lib1", To: []string{"tool"}})
+
+	sut := NewCDX("1.5", "json")
+	var buf bytes.Buffer
+	require.NoError(t, sut.SerializeStream(context.Background(), doc, &buf, &native.SerializeOptions{}))
+
+	bom := cdx.NewBOM()
+	require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(bom))
+	require.Equal(t, cdx.SpecVersion1_5, bom.SpecVersion)
+	require.Equal(t, doc.Metadata.Id, bom.SerialNumber)
+	require.Equal(t, 2, bom.Version)
+	require.Equal(t, "John Doe", (*bom.Metadata.Authors)[0].Name)
+	require.Equal(t, "app", bom.Metadata.Component.BOMRef)
+	require.Nil(t, bom.Metadata.Component.Components)
+
+	// The root components are at the top level, the rest stay nested
+	require.Len(t, *bom.Components, 3)
+	require.Equal(t, "lib1", (*bom.Components)[0].BOMRef)
+	require.Equal(t, []cdx.Property{
+		{Name: cdxformats.PropertyEdgePrefix + "buildTool", Value: "tool"},
+	}, *(*bom.Components)[0].Properties)
+	require.Equal(t, "lib2", (*bom.Components)[1].BOMRef)
+	require.Len(t, *(*bom.Components)[1].Components, 1)
+	require.Equal(t, "file1", (*(*bom.Components)[1].Components)[0].BOMRef)
+	require.Equal(t, "tool", (*bom.Components)[2].BOMRef)
+
+	require.Equal(t, []cdx.Dependency{
+		{Ref: "app", Dependencies: &[]string{"lib1"}},
+		{Ref: "lib1", Dependencies: &[]string{"lib2"}},
+	}, *bom.Dependencies)
+}
+
+func TestSerializeStreamBatches(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	ids := []string{}
+	for i := 0; i < streamBatchSize*2+1; i++ {
+		id := fmt.Sprintf("lib%d", i)
+		ids = append(ids, id)
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id, PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: id, To: []string{"app"}})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: ids})
+
+	sut := NewCDX("1.4", "json")
+	var buf bytes.Buffer
+	require.NoError(t, sut.SerializeStream(context.Background(), doc, &buf, &native.SerializeOptions{}))
+
+	bom := cdx.NewBOM()
+	require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(bom))
+	require.Equal(t, cdx.SpecVersion1_4, bom.SpecVersion)
+	require.Len(t, *bom.Components, len(ids))
+	require.Len(t, *bom.Dependencies, len(ids))
+	for i := range ids {
+		require.Equal(t, ids[i], (*bom.Components)[i].BOMRef)
+		require.Equal(t, ids[i], (*bom.Dependencies)[i].Ref)
+	}
+}
+
+func TestSerializeStreamErrors(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	for name, tc := range map[string]struct {
+		sut  *CDX
+		doc  *sbom.Document
+		opts *native.SerializeOptions
+	}{
+		"xml encoding":        {NewCDX("1.5", "xml"), doc, &native.SerializeOptions{}},
+		"whole document":      {NewCDX("1.5", "json"), doc, &native.SerializeOptions{FlattenDependencies: true}},
+		"unknown suppliers":   {NewCDX("1.5", "json"), doc, &native.SerializeOptions{ExtraSuppliers: "nope"}},
+		"incomplete document": {NewCDX("1.5", "json"), &sbom.Document{}, &native.SerializeOptions{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, tc.sut.SerializeStream(context.Background(), tc.doc, io.Discard, tc.opts))
+		})
+	}
+}
+
+func TestSerializeStreamLifecycles(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Lifecycles = []*sbom.Lifecycle{{Phase: sbom.Lifecycle_BUILD}}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	// Lifecycle phases are not valid before CycloneDX 1.5, both paths
+	// must drop them and report it
+	sut := NewCDX("1.4", "json")
+	degradations := []native.Degradation{}
+	opts := &native.SerializeOptions{Degradations: &degradations}
+	var streamed bytes.Buffer
+	require.NoError(t, sut.SerializeStream(context.Background(), doc, &streamed, opts))
+	require.Equal(t, []native.Degradation{
+		{Field: "lifecycle phase", Message: `invalid lifecycle phase "build" removed`},
+	}, degradations)
+
+	out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	var buffered bytes.Buffer
+	require.NoError(t, sut.Render(out, &buffered, &native.RenderOptions{Compact: true}, nil))
+	require.NotContains(t, buffered.String(), `"build"`)
+	require.NotContains(t, streamed.String(), `"build"`)
+}
+
+// peakHeapWriter discards the data written to it, sampling the live heap
+// measured by the last garbage collection to record its peak.
+type peakHeapWriter struct {
+	sample []metrics.Sample
+	peak   uint64
+}
+
+func newPeakHeapWriter() *peakHeapWriter {
+	return &peakHeapWriter{sample: []metrics.Sample{{Name: "/gc/heap/live:bytes"}}}
+}
+
+func (w *peakHeapWriter) Write(p []byte) (int, error) {
+	w.read()
+	return len(p), nil
+}
+
+func (w *peakHeapWriter) read() {
+	metrics.Read(w.sample)
+	if v := w.sample[0].Value.Uint64(); v > w.peak {
+		w.peak = v
+	}
+}
+
+// BenchmarkSerializeLarge compares the peak heap of the buffered and the
+// streaming serializers on a large document.
+func BenchmarkSerializeLarge(b *testing.B) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	ids := []string{}
+	for i := 0; i < 50000; i++ {
+		id := fmt.Sprintf("lib%d", i)
+		ids = append(ids, id)
+		doc.NodeList.AddNode(&sbom.Node{
+			Id: id, Name: id, Version: "1.0.0",
+			PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+			Licenses:       []string{"Apache-2.0"},
+			Identifiers: map[int32]string{
+				int32(sbom.SoftwareIdentifierType_PURL): fmt.Sprintf("pkg:generic/%s@1.0.0", id),
+			},
+		})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: ids})
+	for i := 1; i < len(ids); i++ {
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: ids[i-1], To: []string{ids[i]}})
+	}
+	sut := NewCDX("1.5", "json")
+
+	for name, serialize := range map[string]func(io.Writer) error{
+		"buffered": func(wr io.Writer) error {
+			bom, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+			if err != nil {
+				return err
+			}
+			return sut.Render(bom, wr, &native.RenderOptions{}, nil)
+		},
+		"stream": func(wr io.Writer) error {
+			return sut.SerializeStream(context.Background(), doc, wr, &native.SerializeOptions{})
+		},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			var peak uint64
+			for i := 0; i < b.N; i++ {
+				runtime.GC()
+				wr := newPeakHeapWriter()
+				wr.read()
+				base := wr.peak
+				if err := serialize(wr); err != nil {
+					b.Fatal(err)
+				}
+				if wr.peak-base > peak {
+					peak = wr.peak - base
+				}
+			}
+			b.ReportMetric(float64(peak), "peak-heap-B")
+		})
+	}
+}
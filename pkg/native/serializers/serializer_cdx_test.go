@@ -1,12 +1,26 @@
 package serializers
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
 	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestComponentType(t *testing.T) {
@@ -108,6 +122,7 @@ func TestProtobomExtRefTypeToCdxType(t *testing.T) {
 		sbom.ExternalReference_POAM:                                    cdx.ExternalReferenceType("poam"),
 		sbom.ExternalReference_QUALITY_METRICS:                         cdx.ERTypeQualityMetrics,
 		sbom.ExternalReference_RELEASE_NOTES:                           cdx.ERTypeReleaseNotes,
+		sbom.ExternalReference_RFC_9116:                                cdx.ERTypeSecurityContact,
 		sbom.ExternalReference_RISK_ASSESSMENT:                         cdx.ERTypeRiskAssessment,
 		sbom.ExternalReference_RUNTIME_ANALYSIS_REPORT:                 cdx.ERTypeRuntimeAnalysisReport,
 		sbom.ExternalReference_SECURITY_ADVERSARY_MODEL:                cdx.ERTypeAdversaryModel,
@@ -126,6 +141,20 @@ func TestProtobomExtRefTypeToCdxType(t *testing.T) {
 		res := cdxs.protobomExtRefTypeToCdxType(cdxRefType)
 		require.Equal(t, protoType, res)
 	}
+
+	// Unknown types and types newer than the target version become other
+	require.Equal(t, cdx.ERTypeOther, cdxs.protobomExtRefTypeToCdxType(sbom.ExternalReference_ExternalReferenceType(999)))
+	cdx14 := NewCDX("1.4", "json")
+	require.Equal(t, cdx.ERTypeOther, cdx14.protobomExtRefTypeToCdxType(sbom.ExternalReference_FORMULATION))
+	require.Equal(t, cdx.ERTypeOther, cdx14.protobomExtRefTypeToCdxType(sbom.ExternalReference_RFC_9116))
+	require.Equal(t, cdx.ERTypeReleaseNotes, cdx14.protobomExtRefTypeToCdxType(sbom.ExternalReference_RELEASE_NOTES))
+
+	// The original type is kept in the comment to read it back
+	refs := cdx14.externalReferencesToCDX([]*sbom.ExternalReference{
+		{Url: "https://example.com/formulation.json", Type: sbom.ExternalReference_FORMULATION},
+	})
+	require.Equal(t, cdx.ERTypeOther, refs[0].Type)
+	require.Equal(t, cdxformats.ExtRefTypeCommentPrefix+"formulation", refs[0].Comment)
 }
 
 func TestProtoHashAlgoToCdxAlgo(t *testing.T) {
@@ -190,3 +219,2015 @@ func TestPurposeToComponentType(t *testing.T) {
 		require.Equal(t, cdxType, res)
 	}
 }
+
+func TestNodeIdentifiersToComponent(t *testing.T) {
+	// SWHIDs have a native field from 1.6 on
+	sut := NewCDX("1.5", "json")
+	for _, tc := range []struct {
+		name        string
+		identifiers map[int32]string
+		purl        string
+		cpe         string
+		properties  []cdx.Property
+	}{
+		{
+			name: "purl and cpe",
+			identifiers: map[int32]string{
+				int32(sbom.SoftwareIdentifierType_PURL):  "pkg:generic/test@1.0.0",
+				int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:test:test:1.0.0:*:*:*:*:*:*:*",
+			},
+			purl: "pkg:generic/test@1.0.0",
+			cpe:  "cpe:2.3:a:test:test:1.0.0:*:*:*:*:*:*:*",
+		},
+		{
+			name: "only swid",
+			identifiers: map[int32]string{
+				int32(sbom.SoftwareIdentifierType_SWID): "swid:example.com/test@1.0.0",
+			},
+			properties: []cdx.Property{
+				{Name: "protobom:identifier:swid", Value: "swid:example.com/test@1.0.0"},
+			},
+		},
+		{
+			name: "purl, swid and swhid",
+			identifiers: map[int32]string{
+				int32(sbom.SoftwareIdentifierType_PURL):  "pkg:generic/test@1.0.0",
+				int32(sbom.SoftwareIdentifierType_SWHID): "swh:1:cnt:94a9ed024d3859793618152ea559a168bbcbb5e2",
+				int32(sbom.SoftwareIdentifierType_SWID):  "swid:example.com/test@1.0.0",
+			},
+			purl: "pkg:generic/test@1.0.0",
+			properties: []cdx.Property{
+				{Name: "protobom:identifier:swid", Value: "swid:example.com/test@1.0.0"},
+				{Name: "protobom:identifier:swhid", Value: "swh:1:cnt:94a9ed024d3859793618152ea559a168bbcbb5e2"},
+			},
+		},
+		{
+			name: "undefined identifier types",
+			identifiers: map[int32]string{
+				-1:                                      "negative",
+				int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/test@1.0.0",
+				42:                                      "future",
+			},
+			purl: "pkg:generic/test@1.0.0",
+			properties: []cdx.Property{
+				{Name: "protobom:identifier:-1", Value: "negative"},
+				{Name: "protobom:identifier:42", Value: "future"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &sbom.Node{Id: "test", Name: "test", Identifiers: tc.identifiers}
+			comp := sut.nodeToComponent(node)
+			require.NotNil(t, comp)
+			require.Equal(t, tc.purl, comp.PackageURL)
+			require.Equal(t, tc.cpe, comp.CPE)
+			if tc.properties == nil {
+				require.Nil(t, comp.Properties)
+				return
+			}
+			require.NotNil(t, comp.Properties)
+			require.Equal(t, tc.properties, *comp.Properties)
+		})
+	}
+}
+
+func TestNodeManufacturerToComponent(t *testing.T) {
+	supplier := &sbom.Person{Name: "Acme Distribution", IsOrg: true}
+	manufacturer := &sbom.Person{Name: "Acme Labs", IsOrg: true, Url: "https://labs.example.com"}
+	for _, tc := range []struct {
+		name         string
+		version      string
+		suppliers    []*sbom.Person
+		supplier     string
+		manufacturer string
+	}{
+		{name: "1.6 distinct entities", version: "1.6", suppliers: []*sbom.Person{supplier}, supplier: "Acme Distribution", manufacturer: "Acme Labs"},
+		{name: "1.6 manufacturer only", version: "1.6", manufacturer: "Acme Labs"},
+		{name: "1.5 supplier wins", version: "1.5", suppliers: []*sbom.Person{supplier}, supplier: "Acme Distribution"},
+		{name: "1.5 manufacturer as supplier", version: "1.5", supplier: "Acme Labs"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := NewCDX(tc.version, "json")
+			comp := sut.nodeToComponent(&sbom.Node{
+				Id: "test", Name: "test", Suppliers: tc.suppliers, Manufacturers: []*sbom.Person{manufacturer},
+			})
+			require.NotNil(t, comp)
+			if tc.supplier == "" {
+				require.Nil(t, comp.Supplier)
+			} else {
+				require.NotNil(t, comp.Supplier)
+				require.Equal(t, tc.supplier, comp.Supplier.Name)
+			}
+			if tc.manufacturer == "" {
+				require.Nil(t, comp.Manufacturer)
+			} else {
+				require.NotNil(t, comp.Manufacturer)
+				require.Equal(t, tc.manufacturer, comp.Manufacturer.Name)
+				require.Equal(t, []string{manufacturer.Url}, *comp.Manufacturer.URL)
+			}
+		})
+	}
+}
+
+func TestAddGeneratorTool(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		tools    []*sbom.Tool
+		enabled  bool
+		expected int
+	}{
+		{name: "disabled", enabled: false, expected: 0},
+		{name: "enabled", enabled: true, expected: 1},
+		{
+			name:     "disabled with other tools",
+			tools:    []*sbom.Tool{{Name: "syft", Version: "1.0.0"}},
+			enabled:  false,
+			expected: 0,
+		},
+		{
+			name:     "enabled with protobom already listed",
+			tools:    []*sbom.Tool{{Name: "protobom", Version: "v0.0.1"}, {Name: "syft", Version: "1.0.0"}},
+			enabled:  true,
+			expected: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.Metadata.Tools = tc.tools
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root"})
+
+			sut := NewCDX("1.5", "json")
+			res, err := sut.Serialize(doc, &native.SerializeOptions{AddGeneratorTool: tc.enabled}, nil)
+			require.NoError(t, err)
+			bom, ok := res.(*cdx.BOM)
+			require.True(t, ok)
+
+			// CycloneDX 1.5 lists the tools as components
+			found := 0
+			if bom.Metadata.Tools != nil && bom.Metadata.Tools.Components != nil {
+				for _, tool := range *bom.Metadata.Tools.Components {
+					if tool.Name == "protobom" {
+						found++
+					}
+				}
+			}
+			require.Equal(t, tc.expected, found)
+		})
+	}
+}
+
+func TestRootSubcomponents(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "child1", Name: "child1"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "child2", Name: "child2"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "dependency", Name: "dependency"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"child1", "child2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "root", To: []string{"dependency"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "child1", To: []string{"dependency"}})
+
+	sut := NewCDX("1.5", "json")
+	res, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	bom, ok := res.(*cdx.BOM)
+	require.True(t, ok)
+
+	// The children must nest under the metadata component
+	require.Equal(t, "root", bom.Metadata.Component.BOMRef)
+	require.NotNil(t, bom.Metadata.Component.Components)
+	require.Len(t, *bom.Metadata.Component.Components, 2)
+	require.Equal(t, "child1", (*bom.Metadata.Component.Components)[0].BOMRef)
+	require.Equal(t, "child2", (*bom.Metadata.Component.Components)[1].BOMRef)
+
+	// ... and only the dependency remains at the top level
+	require.Len(t, *bom.Components, 1)
+	require.Equal(t, "dependency", (*bom.Components)[0].BOMRef)
+
+	require.Equal(t, []cdx.Dependency{
+		{Ref: "root", Dependencies: &[]string{"dependency"}},
+		{Ref: "child1", Dependencies: &[]string{"dependency"}},
+	}, *bom.Dependencies)
+}
+
+func TestDisableProperties(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		disable  bool
+		expected int
+	}{
+		{name: "properties enabled", disable: false, expected: 1},
+		{name: "properties disabled", disable: true, expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root"})
+			doc.NodeList.AddNode(&sbom.Node{
+				Id: "child", Name: "child",
+				Identifiers: map[int32]string{
+					int32(sbom.SoftwareIdentifierType_SWID): "swid:example.com/test@1.0.0",
+				},
+			})
+			doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"child"}})
+
+			sut := NewCDX("1.5", "json")
+			res, err := sut.Serialize(doc, &native.SerializeOptions{DisableProperties: tc.disable}, nil)
+			require.NoError(t, err)
+			bom, ok := res.(*cdx.BOM)
+			require.True(t, ok)
+
+			child := (*bom.Metadata.Component.Components)[0]
+			if tc.expected == 0 {
+				require.Nil(t, child.Properties)
+				return
+			}
+			require.Len(t, *child.Properties, tc.expected)
+		})
+	}
+}
+
+func TestFlattenDependencies(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sut      []cdx.Dependency
+		expected []cdx.Dependency
+	}{
+		{
+			name: "chain",
+			sut: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b"}},
+				{Ref: "b", Dependencies: &[]string{"c"}},
+			},
+			expected: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b", "c"}},
+				{Ref: "b", Dependencies: &[]string{"c"}},
+			},
+		},
+		{
+			name: "cycle",
+			sut: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b"}},
+				{Ref: "b", Dependencies: &[]string{"c"}},
+				{Ref: "c", Dependencies: &[]string{"a"}},
+			},
+			expected: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b", "c"}},
+				{Ref: "b", Dependencies: &[]string{"c", "a"}},
+				{Ref: "c", Dependencies: &[]string{"a", "b"}},
+			},
+		},
+		{
+			name: "diamond",
+			sut: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b", "c"}},
+				{Ref: "b", Dependencies: &[]string{"d"}},
+				{Ref: "c", Dependencies: &[]string{"d"}},
+			},
+			expected: []cdx.Dependency{
+				{Ref: "a", Dependencies: &[]string{"b", "c", "d"}},
+				{Ref: "b", Dependencies: &[]string{"d"}},
+				{Ref: "c", Dependencies: &[]string{"d"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, flattenDependencies(tc.sut))
+		})
+	}
+}
+
+func TestSerializeFlattenDependencies(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		flatten  bool
+		expected []string
+	}{
+		{name: "direct dependencies", flatten: false, expected: []string{"b"}},
+		{name: "flattened dependencies", flatten: true, expected: []string{"b", "c"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "a", Name: "a"})
+			doc.NodeList.AddNode(&sbom.Node{Id: "b", Name: "b"})
+			doc.NodeList.AddNode(&sbom.Node{Id: "c", Name: "c"})
+			doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "a", To: []string{"b"}})
+			doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "b", To: []string{"c"}})
+
+			sut := NewCDX("1.5", "json")
+			res, err := sut.Serialize(doc, &native.SerializeOptions{FlattenDependencies: tc.flatten}, nil)
+			require.NoError(t, err)
+			bom, ok := res.(*cdx.BOM)
+			require.True(t, ok)
+
+			require.Equal(t, "a", (*bom.Dependencies)[0].Ref)
+			require.Equal(t, tc.expected, *(*bom.Dependencies)[0].Dependencies)
+		})
+	}
+}
+
+func TestSerializeSortDependencies(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"zlib", "curl", "musl", "bash"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"zlib", "curl", "musl"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "curl", To: []string{"zlib", "bash"}})
+
+	for _, tc := range []struct {
+		name     string
+		opts     native.SerializeOptions
+		expected [][]string
+	}{
+		{
+			name:     "edge order",
+			expected: [][]string{{"zlib", "curl", "musl"}, {"zlib", "bash"}},
+		},
+		{
+			name:     "sorted",
+			opts:     native.SerializeOptions{SortDependencies: true},
+			expected: [][]string{{"curl", "musl", "zlib"}, {"bash", "zlib"}},
+		},
+		{
+			name:     "sorted and flattened",
+			opts:     native.SerializeOptions{SortDependencies: true, FlattenDependencies: true},
+			expected: [][]string{{"bash", "curl", "musl", "zlib"}, {"bash", "zlib"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := tc.opts
+			res, err := NewCDX("1.5", "json").Serialize(doc, &opts, nil)
+			require.NoError(t, err)
+			deps := *res.(*cdx.BOM).Dependencies
+			require.Len(t, deps, 2)
+			require.Equal(t, "app", deps[0].Ref)
+			require.Equal(t, tc.expected[0], *deps[0].Dependencies)
+			require.Equal(t, "curl", deps[1].Ref)
+			require.Equal(t, tc.expected[1], *deps[1].Dependencies)
+		})
+	}
+}
+
+func TestLifecycles(t *testing.T) {
+	sut := NewCDX("1.5", "json")
+	for _, tc := range []struct {
+		name     string
+		md       *sbom.Metadata
+		expected []cdx.Lifecycle
+	}{
+		{
+			name: "dedicated list",
+			md: &sbom.Metadata{
+				Lifecycles: []*sbom.Lifecycle{
+					{Phase: sbom.Lifecycle_PRE_BUILD},
+					{Phase: sbom.Lifecycle_POST_BUILD},
+					{Phase: sbom.Lifecycle_CUSTOM, Name: "testing", Description: "Integration tests"},
+				},
+			},
+			expected: []cdx.Lifecycle{
+				{Phase: cdx.LifecyclePhasePreBuild},
+				{Phase: cdx.LifecyclePhasePostBuild},
+				{Name: "testing", Description: "Integration tests"},
+			},
+		},
+		{
+			name: "document types",
+			md: &sbom.Metadata{
+				DocumentTypes: []*sbom.DocumentType{
+					{Type: sbom.DocumentType_BUILD.Enum()},
+				},
+			},
+			expected: []cdx.Lifecycle{
+				{Phase: cdx.LifecyclePhaseBuild},
+			},
+		},
+		{
+			name: "both lists are merged",
+			md: &sbom.Metadata{
+				Lifecycles: []*sbom.Lifecycle{
+					{Phase: sbom.Lifecycle_BUILD},
+				},
+				DocumentTypes: []*sbom.DocumentType{
+					{Type: sbom.DocumentType_BUILD.Enum()},
+					{Type: sbom.DocumentType_DESIGN.Enum()},
+				},
+			},
+			expected: []cdx.Lifecycle{
+				{Phase: cdx.LifecyclePhaseBuild},
+				{Phase: cdx.LifecyclePhaseDesign},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := sut.lifecycles(tc.md)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func BenchmarkNodeToComponent(b *testing.B) {
+	sut := CDX{}
+	node := &sbom.Node{
+		Id:      "large-node",
+		Name:    "large-node",
+		Version: "1.0.0",
+		Hashes: map[int32]string{
+			int32(sbom.HashAlgorithm_MD5):         "3942447fac867ae5cdb3229b658f4d48",
+			int32(sbom.HashAlgorithm_SHA1):        "e6b1000b94e835ffd37f4c6dcbdad43f4b48a02a",
+			int32(sbom.HashAlgorithm_SHA256):      "f498a8ff2dd007e29c2074f5e4b01a9a01775c3ff3aeaf6906ea503bc5791b7b",
+			int32(sbom.HashAlgorithm_SHA384):      "e8f33e424f3f4ed6db76a482fde1a5298970e442c531729119e37991884bdffab4f9426b7ee11fccd074eeda0634d716",
+			int32(sbom.HashAlgorithm_SHA512):      "e8f33e424f3f4ed6db76a482fde1a5298970e442c531729119e37991884bdffab4f9426b7ee11fccd074eeda0634d71697d6f88a460dce0ac8d627a29f7d1282",
+			int32(sbom.HashAlgorithm_SHA3_256):    "a127ceedc934ccbe6e5fc2fac4c1afa2bf59271d2df288dd0cba01fbf93ce694",
+			int32(sbom.HashAlgorithm_SHA3_512):    "8044d0df34242699ad73bfe99b9ac3d6bbdaa4f8ebce1e23ee5c7f9fe59db8ad7b01fe94e886941793aee802008a35b05a30bc51426db796aa21e5e91b7ed9be",
+			int32(sbom.HashAlgorithm_BLAKE2B_256): "c2c306cf6281251126b8bff2e747d89019de78de51324f3a48f9c83b794be46c",
+		},
+	}
+	for i := 0; i < 32; i++ {
+		node.Licenses = append(node.Licenses, fmt.Sprintf("LicenseRef-%d", i))
+		node.ExternalReferences = append(node.ExternalReferences, &sbom.ExternalReference{
+			Type: sbom.ExternalReference_WEBSITE,
+			Url:  fmt.Sprintf("https://example.com/%d", i),
+		})
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sut.nodeToComponent(node)
+	}
+}
+
+// dependsOnDocument returns a document of n nodes related only with
+// dependsOn edges, each node depending on the next two.
+func dependsOnDocument(n int) *sbom.Document {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("lib%d", i)
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id, PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib0"}})
+	for i := 0; i < n-2; i++ {
+		doc.NodeList.AddEdge(&sbom.Edge{
+			Type: sbom.Edge_dependsOn, From: fmt.Sprintf("lib%d", i),
+			To: []string{fmt.Sprintf("lib%d", i+1), fmt.Sprintf("lib%d", i+2)},
+		})
+	}
+	return doc
+}
+
+// dependenciesState returns a serializer state with the components of the
+// document loaded.
+func dependenciesState(tb testing.TB, doc *sbom.Document) (context.Context, *serializerCDXState) {
+	state := newSerializerCDXState()
+	ctx := context.WithValue(context.Background(), stateKey, state)
+	require.NoError(tb, NewCDX("1.5", "json").componentsMaps(ctx, doc))
+	return ctx, state
+}
+
+func TestFlatDependencies(t *testing.T) {
+	doc := dependsOnDocument(10)
+	// Repeated targets are listed once
+	doc.NodeList.Edges = append(doc.NodeList.Edges, &sbom.Edge{
+		Type: sbom.Edge_dependsOn, From: "lib0", To: []string{"lib1", "lib9"},
+	})
+	opts := &native.SerializeOptions{}
+	require.True(t, dependencyEdgesOnly(doc.NodeList.Edges, opts))
+
+	ctx, state := dependenciesState(t, doc)
+	flat, err := flatDependencies(ctx, state, doc.NodeList.Edges)
+	require.NoError(t, err)
+	graph, err := graphDependencies(ctx, state, doc, opts)
+	require.NoError(t, err)
+	require.Equal(t, graph, flat)
+	require.Equal(t, []string{"lib1", "lib2", "lib9"}, *flat[1].Dependencies)
+
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib0"}})
+	require.False(t, dependencyEdgesOnly(doc.NodeList.Edges, opts))
+
+	_, err = flatDependencies(ctx, state, []*sbom.Edge{{Type: sbom.Edge_dependsOn, From: "app", To: []string{"nope"}}})
+	require.Error(t, err)
+}
+
+// BenchmarkDependencies compares the dependency graph built for a
+// dependsOn only document by the fast path and by the general path.
+func BenchmarkDependencies(b *testing.B) {
+	doc := dependsOnDocument(20000)
+	opts := &native.SerializeOptions{}
+	ctx, state := dependenciesState(b, doc)
+
+	for name, build := range map[string]func() ([]cdx.Dependency, error){
+		"flat": func() ([]cdx.Dependency, error) {
+			return flatDependencies(ctx, state, doc.NodeList.Edges)
+		},
+		"graph": func() ([]cdx.Dependency, error) {
+			return graphDependencies(ctx, state, doc, opts)
+		},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := build(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupComponentsByType(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib1", Name: "lib1", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file1", Name: "file1", Type: sbom.Node_FILE})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib2", Name: "lib2", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file2", Name: "file2", Type: sbom.Node_FILE})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "file1", "lib2", "file2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+
+	sut := NewCDX("1.5", "json")
+	out, err := sut.Serialize(doc, &native.SerializeOptions{GroupComponentsByType: true}, nil)
+	require.NoError(t, err)
+	bom := out.(*cdx.BOM)
+
+	groups := *bom.Metadata.Component.Components
+	require.Len(t, groups, 2)
+	for i, tc := range []struct {
+		componentType cdx.ComponentType
+		members       []string
+	}{
+		{cdx.ComponentTypeLibrary, []string{"lib1", "lib2"}},
+		{cdx.ComponentTypeFile, []string{"file1", "file2"}},
+	} {
+		require.Equal(t, tc.componentType, groups[i].Type)
+		require.Equal(t, []cdx.Property{{Name: "protobom:group", Value: string(tc.componentType)}}, *groups[i].Properties)
+		members := []string{}
+		for _, c := range *groups[i].Components {
+			require.Equal(t, tc.componentType, c.Type)
+			members = append(members, c.BOMRef)
+		}
+		require.Equal(t, tc.members, members)
+	}
+
+	// Groups are not part of the dependency graph
+	require.Equal(t, []cdx.Dependency{{Ref: "lib1", Dependencies: &[]string{"lib2"}}}, *bom.Dependencies)
+}
+
+func TestSerializeInvalidUTF8(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app\xff\xfe", Version: "1.0.0"})
+
+	for _, strict := range []bool{false, true} {
+		t.Run(fmt.Sprintf("strict=%v", strict), func(t *testing.T) {
+			sut := NewCDX("1.5", "json")
+			bom, err := sut.Serialize(doc, &native.SerializeOptions{StrictUTF8: strict}, nil)
+			if strict {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, sut.Render(bom, &buf, &native.RenderOptions{}, nil))
+			require.True(t, utf8.Valid(buf.Bytes()))
+			require.Contains(t, buf.String(), "app�")
+		})
+	}
+}
+
+func TestComponentsSHA256(t *testing.T) {
+	buildDoc := func(libVersion string) *sbom.Document {
+		doc := sbom.NewDocument()
+		doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+		doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", Version: libVersion})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+		return doc
+	}
+
+	digest := func(doc *sbom.Document, enabled bool) string {
+		sut := NewCDX("1.5", "json")
+		out, err := sut.Serialize(doc, &native.SerializeOptions{ComponentsSHA256: enabled}, nil)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		if bom.Metadata.Properties == nil {
+			return ""
+		}
+		for _, p := range *bom.Metadata.Properties {
+			if p.Name == "protobom:components:sha256" {
+				return p.Value
+			}
+		}
+		return ""
+	}
+
+	require.Empty(t, digest(buildDoc("2.0.0"), false))
+
+	d1 := digest(buildDoc("2.0.0"), true)
+	require.Len(t, d1, 64)
+	require.Equal(t, d1, digest(buildDoc("2.0.0"), true))
+	require.NotEqual(t, d1, digest(buildDoc("2.0.1"), true))
+}
+
+func TestSourceSHA256(t *testing.T) {
+	const digest = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	for _, tc := range []struct {
+		name     string
+		source   string
+		enabled  bool
+		expected []cdx.Property
+		mustErr  bool
+	}{
+		{"enabled", digest, true, []cdx.Property{{Name: cdxformats.PropertySourceSHA256, Value: digest}}, false},
+		{"disabled", digest, false, nil, false},
+		{"not parsed", "", true, nil, false},
+		{"too short", digest[:40], true, nil, true},
+		{"not hex", strings.Repeat("z", 64), true, nil, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.Metadata.SourceSha256 = tc.source
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{SourceSHA256: tc.enabled}, nil)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			props := out.(*cdx.BOM).Metadata.Properties
+			if tc.expected == nil {
+				require.Nil(t, props)
+				return
+			}
+			require.Equal(t, tc.expected, *props)
+		})
+	}
+}
+
+func TestNormalizePurls(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		opts      *native.SerializeOptions
+		purl      string
+		alternate string
+	}{
+		{
+			name:      "default normalizer",
+			opts:      &native.SerializeOptions{},
+			purl:      "pkg:npm/lodash@4.17.21",
+			alternate: "pkg:github/lodash/lodash@4.17.21",
+		},
+		{
+			name:      "nil options",
+			purl:      "pkg:npm/lodash@4.17.21",
+			alternate: "pkg:github/lodash/lodash@4.17.21",
+		},
+		{
+			name:      "custom normalizer",
+			opts:      &native.SerializeOptions{PURLNormalizer: strings.ToUpper},
+			purl:      "PKG:NPM/LODASH@4.17.21",
+			alternate: "PKG:GITHUB/LODASH/LODASH@4.17.21",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{
+				Id: "app", Name: "app",
+				Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:NPM/lodash@4.17.21"},
+				AlternatePurls: []string{"pkg:GitHub/Lodash/Lodash@4.17.21"},
+			})
+
+			sut := NewCDX("1.5", "json")
+			out, err := sut.Serialize(doc, tc.opts, nil)
+			require.NoError(t, err)
+			bom := out.(*cdx.BOM)
+			require.Equal(t, tc.purl, bom.Metadata.Component.PackageURL)
+			require.Equal(t, []cdx.Property{{Name: "protobom:identifier:purl", Value: tc.alternate}}, *bom.Metadata.Component.Properties)
+		})
+	}
+}
+
+func TestDependencyGraphOnly(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+		Licenses:       []string{"Apache-2.0"},
+	})
+	for _, id := range []string{"lib1", "lib2"} {
+		doc.NodeList.AddNode(&sbom.Node{
+			Id: id, Name: id, Version: "2.0.0",
+			PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+			Licenses:       []string{"MIT"},
+			Description:    "A library",
+			Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:npm/" + id + "@2.0.0"},
+			Hashes: map[int32]string{
+				int32(sbom.HashAlgorithm_SHA256): "f498a8ff2dd007e29c2074f5e4b01a9a01775c3ff3aeaf6906ea503bc5791b7b",
+			},
+		})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+
+	sut := NewCDX("1.5", "json")
+	out, err := sut.Serialize(doc, &native.SerializeOptions{DependencyGraphOnly: true}, nil)
+	require.NoError(t, err)
+	bom := out.(*cdx.BOM)
+
+	require.Equal(t, []cdx.Dependency{{Ref: "lib1", Dependencies: &[]string{"lib2"}}}, *bom.Dependencies)
+
+	root := bom.Metadata.Component
+	require.Equal(t, cdx.Component{
+		BOMRef: "app", Type: cdx.ComponentTypeApplication, Name: "app", Components: root.Components,
+	}, *root)
+	require.Len(t, *root.Components, 2)
+	for i, id := range []string{"lib1", "lib2"} {
+		require.Equal(t, cdx.Component{
+			BOMRef: id, Type: cdx.ComponentTypeLibrary, Name: id,
+		}, (*root.Components)[i])
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, sut.Render(bom, &buf, &native.RenderOptions{}, nil))
+	require.NotContains(t, buf.String(), "hashes")
+	require.NotContains(t, buf.String(), "licenses")
+	require.Contains(t, buf.String(), "dependencies")
+}
+
+func TestNodeTypeToComponentType(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file", Name: "file", Type: sbom.Node_FILE})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib", "file"}})
+
+	for _, tc := range []struct {
+		name     string
+		mapping  map[sbom.Node_NodeType]cdx.ComponentType
+		expected []cdx.ComponentType
+	}{
+		{
+			name:     "default mapping",
+			expected: []cdx.ComponentType{"", cdx.ComponentTypeLibrary, cdx.ComponentTypeFile},
+		},
+		{
+			name:    "packages as applications",
+			mapping: map[sbom.Node_NodeType]cdx.ComponentType{sbom.Node_PACKAGE: cdx.ComponentTypeApplication},
+			expected: []cdx.ComponentType{
+				cdx.ComponentTypeApplication, cdx.ComponentTypeApplication, cdx.ComponentTypeFile,
+			},
+		},
+		{
+			name:     "files as data",
+			mapping:  map[sbom.Node_NodeType]cdx.ComponentType{sbom.Node_FILE: cdx.ComponentTypeData},
+			expected: []cdx.ComponentType{"", cdx.ComponentTypeLibrary, cdx.ComponentTypeData},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := NewCDX("1.5", "json")
+			out, err := sut.Serialize(doc, &native.SerializeOptions{NodeTypeToComponentType: tc.mapping}, nil)
+			require.NoError(t, err)
+			root := out.(*cdx.BOM).Metadata.Component
+			require.Equal(t, tc.expected, []cdx.ComponentType{
+				root.Type, (*root.Components)[0].Type, (*root.Components)[1].Type,
+			})
+		})
+	}
+}
+
+func TestMetadataComponentDetails(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id:       "app",
+		Name:     "app",
+		Version:  "1.0.0",
+		Licenses: []string{"Apache-2.0"},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Type: sbom.ExternalReference_VCS, Url: "https://github.com/example/app"},
+		},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", Version: "2.0.0"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+
+	sut := NewCDX("1.5", "json")
+	out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+
+	rendered := &cdx.BOM{}
+	require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(rendered))
+	root := rendered.Metadata.Component
+	require.NotNil(t, root)
+	require.Equal(t, "app", root.BOMRef)
+
+	require.NotNil(t, root.Licenses)
+	require.Len(t, *root.Licenses, 1)
+	require.Equal(t, "Apache-2.0", (*root.Licenses)[0].License.ID)
+
+	require.NotNil(t, root.ExternalReferences)
+	require.Len(t, *root.ExternalReferences, 1)
+	require.Equal(t, cdx.ERTypeVCS, (*root.ExternalReferences)[0].Type)
+	require.Equal(t, "https://github.com/example/app", (*root.ExternalReferences)[0].URL)
+}
+
+func TestNodeOriginatorsToAuthors(t *testing.T) {
+	node := &sbom.Node{
+		Id:   "lib",
+		Name: "lib",
+		Originators: []*sbom.Person{
+			{Name: "Jane Doe", Email: "jane@example.com"},
+			{Name: "John Doe", Phone: "555-0100"},
+		},
+	}
+
+	for _, tc := range []struct {
+		version string
+		author  string
+		authors *[]cdx.OrganizationalContact
+	}{
+		{version: "1.4", author: "Jane Doe, John Doe"},
+		{version: "1.5", author: "Jane Doe, John Doe"},
+		{
+			version: "1.6",
+			authors: &[]cdx.OrganizationalContact{
+				{Name: "Jane Doe", Email: "jane@example.com"},
+				{Name: "John Doe", Phone: "555-0100"},
+			},
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			c := NewCDX(tc.version, "json").nodeToComponent(node)
+			require.Equal(t, tc.author, c.Author)
+			require.Equal(t, tc.authors, c.Authors)
+		})
+	}
+}
+
+func TestCopyrightByComponentType(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "os", Name: "os", Copyright: "Copyright The OS Authors",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_OPERATING_SYSTEM},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Copyright: "Copyright The Lib Authors",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "os", To: []string{"lib"}})
+
+	for _, tc := range []struct {
+		name  string
+		force bool
+		os    string
+	}{
+		{name: "default", os: ""},
+		{name: "forced", force: true, os: "Copyright The OS Authors"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{ForceCopyright: tc.force}, nil)
+			require.NoError(t, err)
+			root := out.(*cdx.BOM).Metadata.Component
+			require.Equal(t, cdx.ComponentTypeOS, root.Type)
+			require.Equal(t, tc.os, root.Copyright)
+			require.Equal(t, cdx.ComponentTypeLibrary, (*root.Components)[0].Type)
+			require.Equal(t, "Copyright The Lib Authors", (*root.Components)[0].Copyright)
+		})
+	}
+}
+
+func TestSerializeDiagnostics(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"lib1", "lib2", "file1", "orphan"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+
+	diagnostics := &CDXDiagnostics{}
+	out, err := NewCDX("1.5", "json").Serialize(
+		doc, &native.SerializeOptions{}, &CDXOptions{Diagnostics: diagnostics},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "app", diagnostics.Root)
+	require.Equal(t, []string{"lib1", "file1"}, diagnostics.Nested)
+	require.Equal(t, []string{"lib2", "orphan"}, diagnostics.TopLevel)
+
+	bom := out.(*cdx.BOM)
+	require.Len(t, *bom.Components, len(diagnostics.TopLevel))
+	for i, ref := range diagnostics.TopLevel {
+		require.Equal(t, ref, (*bom.Components)[i].BOMRef)
+	}
+
+	// Without the option the serializer works the same
+	_, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, &CDXOptions{})
+	require.NoError(t, err)
+}
+
+func TestSparseNodeFields(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id:       "app",
+		Name:     "app",
+		Version:  "1.0.0",
+		Licenses: []string{""},
+		Hashes:   map[int32]string{int32(sbom.HashAlgorithm_SHA256): ""},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_PURL):  "",
+			int32(sbom.SoftwareIdentifierType_CPE23): "",
+			int32(sbom.SoftwareIdentifierType_SWID):  "",
+		},
+		AlternatePurls:     []string{""},
+		ExternalReferences: []*sbom.ExternalReference{{Type: sbom.ExternalReference_VCS}},
+	})
+
+	t.Run("json", func(t *testing.T) {
+		sut := NewCDX("1.5", "json")
+		out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+
+		rendered := struct {
+			Metadata struct {
+				Component map[string]any `json:"component"`
+			} `json:"metadata"`
+		}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &rendered))
+		keys := []string{}
+		for k := range rendered.Metadata.Component {
+			keys = append(keys, k)
+		}
+		require.ElementsMatch(t, []string{"bom-ref", "type", "name", "version"}, keys)
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		sut := NewCDX("1.5", "xml")
+		out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+		for _, element := range []string{
+			"<description", "<copyright", "<cpe", "<purl", "<licenses", "<hashes", "<properties", "<externalReferences",
+		} {
+			require.NotContains(t, buf.String(), element)
+		}
+		require.Contains(t, buf.String(), "<version>1.0.0</version>")
+	})
+}
+
+func TestComponentDetail(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id:             "lib",
+		Name:           "lib",
+		Version:        "2.0.0",
+		Description:    "A library",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Licenses:       []string{"MIT"},
+		Suppliers:      []*sbom.Person{{Name: "Acme", IsOrg: true}},
+		Hashes: map[int32]string{
+			int32(sbom.HashAlgorithm_SHA256): "4f232eeb99e1663d07f0af1af6ea262bf594934b694228e71fd8f159f9a19f32",
+		},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_PURL): "pkg:npm/lib@2.0.0",
+		},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Type: sbom.ExternalReference_VCS, Url: "https://github.com/example/lib"},
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+
+	serialize := func(t *testing.T, level native.ComponentDetail) *cdx.Component {
+		out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{ComponentDetail: level}, nil)
+		require.NoError(t, err)
+		root := out.(*cdx.BOM).Metadata.Component
+		require.Equal(t, "app", root.BOMRef)
+		require.Len(t, *root.Components, 1)
+		return &(*root.Components)[0]
+	}
+
+	t.Run("full", func(t *testing.T) {
+		c := serialize(t, native.ComponentDetailFull)
+		require.Equal(t, "A library", c.Description)
+		require.NotNil(t, c.ExternalReferences)
+		require.NotNil(t, c.Hashes)
+	})
+
+	t.Run("standard", func(t *testing.T) {
+		c := serialize(t, native.ComponentDetailStandard)
+		require.Empty(t, c.Description)
+		require.Nil(t, c.ExternalReferences)
+		require.NotNil(t, c.Hashes)
+		require.NotNil(t, c.Licenses)
+		require.NotNil(t, c.Supplier)
+		require.Equal(t, "pkg:npm/lib@2.0.0", c.PackageURL)
+	})
+
+	t.Run("lite", func(t *testing.T) {
+		c := serialize(t, native.ComponentDetailLite)
+		require.Equal(t, cdx.Component{
+			BOMRef:     "lib",
+			Type:       cdx.ComponentTypeLibrary,
+			Name:       "lib",
+			Version:    "2.0.0",
+			PackageURL: "pkg:npm/lib@2.0.0",
+		}, *c)
+		require.Nil(t, c.Hashes)
+		require.Nil(t, c.Licenses)
+		require.Nil(t, c.Supplier)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{ComponentDetail: "verbose"}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestTimestampOverride(t *testing.T) {
+	fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	stored := time.Date(2020, 6, 7, 8, 9, 10, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name      string
+		date      *timestamppb.Timestamp
+		timestamp *time.Time
+		expected  string
+	}{
+		{name: "no date", expected: ""},
+		{name: "zero date", date: &timestamppb.Timestamp{}, expected: ""},
+		{name: "stored date", date: timestamppb.New(stored), expected: "2020-06-07T08:09:10Z"},
+		{name: "override", timestamp: &fixed, expected: "2024-01-02T03:04:05Z"},
+		{name: "override stored date", date: timestamppb.New(stored), timestamp: &fixed, expected: "2024-01-02T03:04:05Z"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.Metadata.Date = tc.date
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{Timestamp: tc.timestamp}, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, out.(*cdx.BOM).Metadata.Timestamp)
+		})
+	}
+}
+
+func TestSerializePruneUnreachable(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"lib", "orphan1", "orphan2"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "orphan1", To: []string{"orphan2"}})
+
+	for _, tc := range []struct {
+		name     string
+		prune    bool
+		topLevel []string
+	}{
+		{name: "default", topLevel: []string{"orphan1", "orphan2"}},
+		{name: "prune", prune: true, topLevel: []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{PruneUnreachable: tc.prune}, nil)
+			require.NoError(t, err)
+			bom := out.(*cdx.BOM)
+			refs := []string{}
+			for _, c := range *bom.Components {
+				refs = append(refs, c.BOMRef)
+			}
+			require.Equal(t, tc.topLevel, refs)
+			require.Equal(t, "lib", (*bom.Metadata.Component.Components)[0].BOMRef)
+		})
+	}
+
+	// The document is not modified
+	require.Len(t, doc.NodeList.Nodes, 4)
+	require.Len(t, doc.NodeList.Edges, 2)
+}
+
+func TestConversionAnnotation(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	doc := sbom.NewDocument()
+	doc.Metadata.SourceFormat = "text/spdx+json;version=2.3"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "tool", Name: "tool", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib", "tool"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_buildTool, From: "tool", To: []string{"app"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_testTool, From: "lib", To: []string{"app"}})
+
+	dropped := map[sbom.Edge_Type]native.EdgeRepresentation{
+		sbom.Edge_buildTool: native.EdgeDropped,
+		sbom.Edge_testTool:  native.EdgeDropped,
+	}
+	out, err := NewCDX("1.5", "json").Serialize(
+		doc, &native.SerializeOptions{Timestamp: &ts, EdgeRepresentations: dropped}, &CDXOptions{ConversionAnnotation: true},
+	)
+	require.NoError(t, err)
+
+	bom := out.(*cdx.BOM)
+	require.NotNil(t, bom.Annotations)
+	require.Len(t, *bom.Annotations, 1)
+	annotation := (*bom.Annotations)[0]
+	require.Equal(t, "2024-01-02T03:04:05Z", annotation.Timestamp)
+	require.Equal(t, "protobom", annotation.Annotator.Component.Name)
+	require.Equal(t, []cdx.BOMReference{"app"}, *annotation.Subjects)
+	require.True(t, strings.HasPrefix(annotation.Text,
+		"Document generated by protobom from text/spdx+json;version=2.3 with 2 degradations:\n"))
+	require.Len(t, strings.Split(annotation.Text, "\n- "), 3)
+
+	// No annotation without the option or before CycloneDX 1.5
+	out, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, out.(*cdx.BOM).Annotations)
+
+	out, err = NewCDX("1.4", "json").Serialize(doc, &native.SerializeOptions{}, &CDXOptions{ConversionAnnotation: true})
+	require.NoError(t, err)
+	require.Nil(t, out.(*cdx.BOM).Annotations)
+}
+
+func TestSerializeDegradations(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:acme:lib:1.0.0:*:*:*:*:*:*:*",
+			int32(sbom.SoftwareIdentifierType_CPE22): "cpe:/a:acme:lib:1.0.0",
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_buildTool, From: "lib", To: []string{"app"}})
+
+	degradations := []native.Degradation{}
+	_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{
+		Degradations:        &degradations,
+		EdgeRepresentations: map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_buildTool: native.EdgeDropped},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, degradations, 2)
+	require.Equal(t, "lib", degradations[0].NodeID)
+	require.Equal(t, "cpe", degradations[0].Field)
+	require.Contains(t, degradations[0].Message, "cpe:/a:acme:lib:1.0.0")
+	require.Equal(t, "lib", degradations[1].NodeID)
+	require.Equal(t, "relationships", degradations[1].Field)
+
+	// The CPE 2.2 identifier is not lost when kept as a property
+	degradations = []native.Degradation{}
+	_, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{
+		Degradations: &degradations, CPE22Property: true,
+	}, nil)
+	require.NoError(t, err)
+	require.Empty(t, degradations)
+}
+
+func TestSerializeDegradationsReported(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Suppliers = []*sbom.Person{{Name: "Acme"}, {Name: "Other"}}
+	doc.Metadata.Manufacturers = []*sbom.Person{{Name: "Acme"}, {Name: "Other"}}
+	doc.Metadata.Tools = []*sbom.Tool{{
+		Name: "scanner", Hashes: map[int32]string{int32(sbom.HashAlgorithm_MD4): "31d6cfe0d16ae931b73c59d7e0c089c0"},
+	}}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:acme:lib:1.0.0:*:*:*:*:*:*:*",
+			int32(sbom.SoftwareIdentifierType_CPE22): "cpe:/a:acme:lib:1.0.0",
+		},
+		Hashes: map[int32]string{
+			int32(sbom.HashAlgorithm_SHA256): "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			int32(sbom.HashAlgorithm_MD4):    "31d6cfe0d16ae931b73c59d7e0c089c0",
+		},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://example.com/lib", Type: sbom.ExternalReference_WEBSITE},
+			{Type: sbom.ExternalReference_VCS, Comment: "no URL"},
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+
+	type key struct{ node, field string }
+	expected := []key{
+		{"", "suppliers"}, {"", "manufacturers"}, {"", "tools"},
+		{"lib", "cpe"}, {"lib", "hashes"}, {"lib", "external references"},
+	}
+
+	sut := NewCDX("1.5", "json")
+	for name, serialize := range map[string]func(*native.SerializeOptions) error{
+		"buffered": func(opts *native.SerializeOptions) error {
+			_, err := sut.Serialize(doc, opts, nil)
+			return err
+		},
+		"stream": func(opts *native.SerializeOptions) error {
+			return sut.SerializeStream(context.Background(), doc, io.Discard, opts)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			degradations := []native.Degradation{}
+			require.NoError(t, serialize(&native.SerializeOptions{Degradations: &degradations}))
+			got := []key{}
+			for _, d := range degradations {
+				got = append(got, key{d.NodeID, d.Field})
+			}
+			require.ElementsMatch(t, expected, got)
+		})
+	}
+}
+
+func TestSerializeSingleNode(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+
+	for _, encoding := range []string{"json", "xml"} {
+		t.Run(encoding, func(t *testing.T) {
+			sut := NewCDX("1.5", encoding)
+			out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+
+			bom := cdx.NewBOM()
+			format := cdx.BOMFileFormatJSON
+			if encoding == "xml" {
+				format = cdx.BOMFileFormatXML
+			}
+			require.NoError(t, cdx.NewBOMDecoder(&buf, format).Decode(bom))
+
+			// Versions start at 1, the protobom default of 0 is not valid
+			require.Equal(t, 1, bom.Version)
+			require.Equal(t, "app", bom.Metadata.Component.BOMRef)
+			require.Equal(t, "app", bom.Metadata.Component.Name)
+			require.Equal(t, cdx.ComponentTypeApplication, bom.Metadata.Component.Type)
+			require.True(t, bom.Components == nil || len(*bom.Components) == 0)
+
+			require.NotNil(t, bom.Dependencies)
+			require.Len(t, *bom.Dependencies, 1)
+			require.Equal(t, "app", (*bom.Dependencies)[0].Ref)
+			require.True(t, (*bom.Dependencies)[0].Dependencies == nil || len(*(*bom.Dependencies)[0].Dependencies) == 0)
+		})
+	}
+}
+
+func TestSerializeMultipleRoots(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Name = "device"
+	for _, id := range []string{"firmware", "bootloader", "recovery"} {
+		doc.NodeList.AddRootNode(&sbom.Node{
+			Id: id, Name: id, Version: "1.0.0",
+			PrimaryPurpose: []sbom.Purpose{sbom.Purpose_FIRMWARE},
+		})
+	}
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "bootloader", To: []string{"lib"}})
+
+	refs := func(comps *[]cdx.Component) []string {
+		ret := []string{}
+		if comps == nil {
+			return ret
+		}
+		for _, c := range *comps {
+			ret = append(ret, c.BOMRef)
+		}
+		return ret
+	}
+
+	// Without a strategy multiple roots are an error
+	_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+	require.Error(t, err)
+
+	_, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MultipleRoots: "bogus"}, nil)
+	require.Error(t, err)
+
+	t.Run("components", func(t *testing.T) {
+		out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MultipleRoots: native.MultipleRootsComponents}, nil)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		require.Equal(t, "firmware", bom.Metadata.Component.BOMRef)
+		require.Equal(t, []string{"bootloader", "recovery", "lib"}, refs(bom.Components))
+		require.Equal(t, []cdx.Dependency{{Ref: "bootloader", Dependencies: &[]string{"lib"}}}, *bom.Dependencies)
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MultipleRoots: native.MultipleRootsAggregate}, nil)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		require.Equal(t, aggregateRootRef(doc.Metadata), bom.Metadata.Component.BOMRef)
+		require.Equal(t, "device", bom.Metadata.Component.Name)
+		require.Equal(t, cdx.ComponentTypeApplication, bom.Metadata.Component.Type)
+		require.Equal(t, []string{"firmware", "bootloader", "recovery"}, refs(bom.Metadata.Component.Components))
+		require.Equal(t, []string{"lib"}, refs(bom.Components))
+
+		// The document is not modified
+		require.Len(t, doc.NodeList.RootElements, 3)
+		require.Len(t, doc.NodeList.Nodes, 4)
+	})
+}
+
+func TestSerializeMaxComponents(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	for _, id := range []string{"lib1", "lib2", "lib3", "lib4", "lib5"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id, PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{id}})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib5"}})
+
+	// Documents within the limit are not affected
+	_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MaxComponents: 6}, nil)
+	require.NoError(t, err)
+
+	t.Run("error", func(t *testing.T) {
+		_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MaxComponents: 4}, nil)
+		require.ErrorContains(t, err, "more than the maximum of 4")
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		out, err := NewCDX("1.5", "json").Serialize(
+			doc, &native.SerializeOptions{MaxComponents: 4, TruncateComponents: true}, nil,
+		)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		require.Equal(t, "app", bom.Metadata.Component.BOMRef)
+		refs := []string{}
+		for _, c := range *bom.Metadata.Component.Components {
+			refs = append(refs, c.BOMRef)
+		}
+		require.Equal(t, []string{"lib1", "lib2", "lib3"}, refs)
+		require.Empty(t, *bom.Components)
+		// The dependency on the truncated node is gone too
+		require.Equal(t, []cdx.Dependency{{Ref: "app"}}, *bom.Dependencies)
+
+		// The document is not modified
+		require.Len(t, doc.NodeList.Nodes, 6)
+	})
+}
+
+func TestSerializeDuplicateIDs(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", Licenses: []string{"MIT"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+		require.ErrorContains(t, err, `["lib"]`)
+		_, err = NewSPDX23().Serialize(doc, &native.SerializeOptions{}, nil)
+		require.ErrorContains(t, err, `["lib"]`)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		out, err := NewCDX("1.5", "json").Serialize(
+			doc, &native.SerializeOptions{DuplicateIDs: native.DuplicateIDsMerge}, nil,
+		)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		require.Len(t, *bom.Metadata.Component.Components, 1)
+		lib := (*bom.Metadata.Component.Components)[0]
+		require.Equal(t, "1.0.0", lib.Version)
+		require.NotNil(t, lib.Licenses)
+
+		// The document is not modified
+		require.Len(t, doc.NodeList.Nodes, 3)
+	})
+}
+
+func TestSerializeContextCanceled(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out, err := NewCDX("1.5", "json").SerializeContext(ctx, doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	cancel()
+	out, err = NewCDX("1.5", "json").SerializeContext(ctx, doc, &native.SerializeOptions{}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, ctx.Err(), err)
+	require.Nil(t, out)
+
+	// The graph walks check the context too
+	ctx = context.WithValue(ctx, stateKey, newSerializerCDXState())
+	require.ErrorIs(t, NewCDX("1.5", "json").componentsMaps(ctx, doc), context.Canceled)
+	_, err = NewCDX("1.5", "json").dependencies(ctx, doc, &native.SerializeOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// cancelAfterContext is a context reporting itself canceled after its
+// error has been checked a number of times.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.checks <= 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
+func TestSerializeContextCanceledMidway(t *testing.T) {
+	const total = 20 * ctxCheckInterval
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for i := 1; i < total; i++ {
+		id := fmt.Sprintf("lib%d", i)
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{id}})
+	}
+
+	// Canceled while building the components
+	state := newSerializerCDXState()
+	ctx := context.WithValue(&cancelAfterContext{Context: context.Background(), checks: 2}, stateKey, state)
+	require.ErrorIs(t, NewCDX("1.5", "json").componentsMaps(ctx, doc), context.Canceled)
+	require.Len(t, state.componentsDict, 2*ctxCheckInterval)
+
+	// Canceled while walking the edges
+	state = newSerializerCDXState()
+	ctx = context.WithValue(context.Background(), stateKey, state)
+	require.NoError(t, NewCDX("1.5", "json").componentsMaps(ctx, doc))
+	ctx = context.WithValue(&cancelAfterContext{Context: context.Background(), checks: 2}, stateKey, state)
+	deps, err := NewCDX("1.5", "json").dependencies(ctx, doc, &native.SerializeOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, deps)
+}
+
+func TestAggregateRootRef(t *testing.T) {
+	newDoc := func(id, name string) *sbom.Document {
+		doc := sbom.NewDocument()
+		doc.Metadata.Id = id
+		doc.Metadata.Name = name
+		doc.NodeList.AddRootNode(&sbom.Node{Id: "firmware", Name: "firmware"})
+		doc.NodeList.AddRootNode(&sbom.Node{Id: "bootloader", Name: "bootloader"})
+		return doc
+	}
+	rootRef := func(doc *sbom.Document) string {
+		out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{MultipleRoots: native.MultipleRootsAggregate}, nil)
+		require.NoError(t, err)
+		bom := out.(*cdx.BOM)
+		require.Contains(t, *bom.Dependencies, cdx.Dependency{Ref: bom.Metadata.Component.BOMRef})
+		return bom.Metadata.Component.BOMRef
+	}
+
+	serial := "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	ref := rootRef(newDoc(serial, "device"))
+	require.Equal(t, "protobom-aggregate-root-", ref[:len(aggregateRootID)+1])
+
+	// The same document gets the same ref every time
+	for i := 0; i < 3; i++ {
+		require.Equal(t, ref, rootRef(newDoc(serial, "device")))
+	}
+
+	// Other documents get a different one
+	require.NotEqual(t, ref, rootRef(newDoc("urn:uuid:f3b7f8a1-5a4e-4d7e-9b6a-0c1d2e3f4a5b", "device")))
+	require.NotEqual(t, ref, rootRef(newDoc(serial, "other device")))
+	require.Equal(t, aggregateRootID, rootRef(newDoc("", "")))
+}
+
+func TestSerializeContainsDependencies(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	for _, id := range []string{"lib1", "lib2", "file1"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib1"}})
+
+	for _, tc := range []struct {
+		name     string
+		enabled  bool
+		expected []cdx.Dependency
+	}{
+		{
+			name:     "disabled",
+			expected: []cdx.Dependency{{Ref: "app", Dependencies: &[]string{"lib1"}}},
+		},
+		{
+			name:    "enabled",
+			enabled: true,
+			expected: []cdx.Dependency{
+				{Ref: "app", Dependencies: &[]string{"lib1", "lib2"}},
+				{Ref: "lib2", Dependencies: &[]string{"file1"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{ContainsDependencies: tc.enabled}, nil)
+			require.NoError(t, err)
+			bom := out.(*cdx.BOM)
+
+			// The components are nested either way
+			nested := *bom.Metadata.Component.Components
+			require.Len(t, nested, 2)
+			require.Equal(t, "lib2", nested[1].BOMRef)
+			require.Equal(t, "file1", (*nested[1].Components)[0].BOMRef)
+			require.Empty(t, *bom.Components)
+
+			require.Equal(t, tc.expected, *bom.Dependencies)
+		})
+	}
+}
+
+func TestSerializeRootDependencies(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	for _, id := range []string{"lib1", "lib2", "file1"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+
+	// By default the root has no entry, none of its edges are dependencies
+	out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []cdx.Dependency{{Ref: "lib1", Dependencies: &[]string{"lib2"}}}, *out.(*cdx.BOM).Dependencies)
+
+	// The root entry lists its direct children only
+	expected := []cdx.Dependency{
+		{Ref: "lib1", Dependencies: &[]string{"lib2"}},
+		{Ref: "app", Dependencies: &[]string{"lib1", "lib2"}},
+	}
+	opts := &native.SerializeOptions{RootDependencies: true}
+	out, err = NewCDX("1.5", "json").Serialize(doc, opts, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, *out.(*cdx.BOM).Dependencies)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewCDX("1.5", "json").SerializeStream(context.Background(), doc, &buf, opts))
+	bom := cdx.NewBOM()
+	require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(bom))
+	require.ElementsMatch(t, expected, *bom.Dependencies)
+}
+
+func TestSerializeEdgeRepresentations(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	for _, id := range []string{"lib1", "lib2", "compiler"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_buildTool, From: "app", To: []string{"compiler"}})
+
+	refs := func(comps *[]cdx.Component) []string {
+		ret := []string{}
+		if comps == nil {
+			return ret
+		}
+		for _, c := range *comps {
+			ret = append(ret, c.BOMRef)
+		}
+		return ret
+	}
+
+	for _, tc := range []struct {
+		name       string
+		mapping    map[sbom.Edge_Type]native.EdgeRepresentation
+		nested     []string
+		components []string
+		deps       []cdx.Dependency
+	}{
+		{
+			name:       "defaults",
+			nested:     []string{"lib1"},
+			components: []string{"lib2", "compiler"},
+			deps:       []cdx.Dependency{{Ref: "lib1", Dependencies: &[]string{"lib2"}}},
+		},
+		{
+			name:       "contains as dependency",
+			mapping:    map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_contains: native.EdgeDependency},
+			nested:     []string{},
+			components: []string{"lib1", "lib2", "compiler"},
+			deps: []cdx.Dependency{
+				{Ref: "app", Dependencies: &[]string{"lib1"}},
+				{Ref: "lib1", Dependencies: &[]string{"lib2"}},
+			},
+		},
+		{
+			name:       "dependsOn dropped",
+			mapping:    map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_dependsOn: native.EdgeDropped},
+			nested:     []string{"lib1"},
+			components: []string{"lib2", "compiler"},
+			deps:       []cdx.Dependency{{Ref: "app"}},
+		},
+		{
+			name:       "buildTool nested",
+			mapping:    map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_buildTool: native.EdgeNested},
+			nested:     []string{"lib1", "compiler"},
+			components: []string{"lib2"},
+			deps:       []cdx.Dependency{{Ref: "lib1", Dependencies: &[]string{"lib2"}}},
+		},
+		{
+			name:       "buildTool as dependency",
+			mapping:    map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_buildTool: native.EdgeDependency},
+			nested:     []string{"lib1"},
+			components: []string{"lib2", "compiler"},
+			deps: []cdx.Dependency{
+				{Ref: "lib1", Dependencies: &[]string{"lib2"}},
+				{Ref: "app", Dependencies: &[]string{"compiler"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{EdgeRepresentations: tc.mapping}, nil)
+			require.NoError(t, err)
+			bom := out.(*cdx.BOM)
+			require.Equal(t, tc.nested, refs(bom.Metadata.Component.Components))
+			require.Equal(t, tc.components, refs(bom.Components))
+			require.Equal(t, tc.deps, *bom.Dependencies)
+		})
+	}
+
+	_, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{
+		EdgeRepresentations: map[sbom.Edge_Type]native.EdgeRepresentation{sbom.Edge_contains: "flat"},
+	}, nil)
+	require.Error(t, err)
+}
+
+func TestSerializeVersionInvalidEnums(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "dataset", Name: "dataset",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_DATA},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://example.com/evidence", Type: sbom.ExternalReference_EVIDENCE},
+		},
+	})
+
+	for version, expected := range map[string]struct {
+		componentType cdx.ComponentType
+		extRefType    cdx.ExternalReferenceType
+	}{
+		"1.4": {cdx.ComponentTypeLibrary, cdx.ERTypeOther},
+		"1.5": {cdx.ComponentTypeData, cdx.ERTypeEvidence},
+	} {
+		t.Run(version, func(t *testing.T) {
+			out, err := NewCDX(version, "json").Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+			c := out.(*cdx.BOM).Metadata.Component
+			require.Equal(t, expected.componentType, c.Type)
+			require.Equal(t, expected.extRefType, (*c.ExternalReferences)[0].Type)
+		})
+	}
+}
+
+func TestRenderPretty(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib", Version: "2.0.0"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+
+	for name, sut := range map[string]native.Serializer{
+		"cyclonedx": NewCDX("1.5", "json"),
+		"spdx":      NewSPDX23(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var pretty, unset, compact bytes.Buffer
+			require.NoError(t, sut.Render(out, &pretty, &native.RenderOptions{Indent: 2}, nil))
+			require.NoError(t, sut.Render(out, &unset, nil, nil))
+			require.NoError(t, sut.Render(out, &compact, &native.RenderOptions{Indent: 2, Compact: true}, nil))
+
+			require.Greater(t, strings.Count(pretty.String(), "\n"), 1)
+			require.Greater(t, strings.Count(unset.String(), "\n"), 1)
+			require.NotContains(t, strings.TrimSuffix(compact.String(), "\n"), "\n")
+			require.Less(t, compact.Len(), pretty.Len())
+			require.True(t, json.Valid(compact.Bytes()))
+		})
+	}
+}
+
+func TestSerializeNodeList(t *testing.T) {
+	nl := &sbom.NodeList{}
+	nl.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+	nl.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "2.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+	})
+	nl.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	nl.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}})
+
+	sut := NewCDX("1.5", "json")
+	var buf bytes.Buffer
+	require.NoError(t, sut.SerializeNodeList(context.Background(), nl, &buf, &native.SerializeOptions{}, nil))
+	require.Contains(t, buf.String(), "\n  ")
+
+	bom := cdx.NewBOM()
+	require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(bom))
+	require.Equal(t, cdx.SpecVersion1_5, bom.SpecVersion)
+	require.NotNil(t, bom.Metadata)
+	require.Equal(t, "app", bom.Metadata.Component.BOMRef)
+	require.NotNil(t, bom.Metadata.Component.Components)
+	require.Len(t, *bom.Metadata.Component.Components, 1)
+	require.Equal(t, "lib", (*bom.Metadata.Component.Components)[0].BOMRef)
+	require.NotNil(t, bom.Dependencies)
+	require.Contains(t, *bom.Dependencies, cdx.Dependency{Ref: "app", Dependencies: &[]string{"lib"}})
+
+	// The caller picks the rendering
+	buf.Reset()
+	require.NoError(t, sut.SerializeNodeList(
+		context.Background(), nl, &buf, &native.SerializeOptions{}, &native.RenderOptions{Compact: true},
+	))
+	require.NotContains(t, buf.String(), "\n  ")
+	require.True(t, json.Valid(buf.Bytes()))
+
+	require.Error(t, sut.SerializeNodeList(context.Background(), nil, &buf, &native.SerializeOptions{}, nil))
+}
+
+func TestRenderIndent(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+
+	sut := NewCDX("1.5", "json")
+	out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	render := func(indent int) string {
+		var buf bytes.Buffer
+		require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{Indent: indent}, nil))
+		return buf.String()
+	}
+
+	// The default is the two spaces of the encoder
+	require.Contains(t, render(0), "\n  \"bomFormat\"")
+	require.Equal(t, render(0), render(2))
+	require.Contains(t, render(4), "\n    \"bomFormat\"")
+	require.Contains(t, render(4), "\n            \"bom-ref\"")
+	require.NotEqual(t, render(2), render(4))
+}
+
+func TestLicensesToCDX(t *testing.T) {
+	// A node with a compound license is written as an expression
+	c := NewCDX("1.5", "json").nodeToComponent(&sbom.Node{
+		Id: "lib", Name: "lib", Licenses: []string{"(MIT OR Apache-2.0)"},
+	})
+	require.Equal(t, cdx.Licenses{{Expression: "(MIT OR Apache-2.0)"}}, *c.Licenses)
+
+	for _, tc := range []struct {
+		name     string
+		licenses []string
+		expected *cdx.Licenses
+	}{
+		{"none", []string{""}, nil},
+		{"ids", []string{"MIT", "Apache-2.0"}, &cdx.Licenses{
+			{License: &cdx.License{ID: "MIT"}}, {License: &cdx.License{ID: "Apache-2.0"}},
+		}},
+		{"expression", []string{"GPL-2.0-only WITH Classpath-exception-2.0"}, &cdx.Licenses{
+			{Expression: "GPL-2.0-only WITH Classpath-exception-2.0"},
+		}},
+		{"mixed", []string{"MIT", "BSD-3-Clause or Apache-2.0"}, &cdx.Licenses{
+			{Expression: "MIT AND (BSD-3-Clause or Apache-2.0)"},
+		}},
+		{"mixed with exception", []string{"Apache-2.0 WITH LLVM-exception", "MIT"}, &cdx.Licenses{
+			{Expression: "Apache-2.0 WITH LLVM-exception AND MIT"},
+		}},
+		{"mixed parenthesized", []string{"(MIT OR Apache-2.0)", "ISC"}, &cdx.Licenses{
+			{Expression: "(MIT OR Apache-2.0) AND ISC"},
+		}},
+		{"mixed nested", []string{"(MIT OR Apache-2.0) AND BSD-3-Clause", "ISC"}, &cdx.Licenses{
+			{Expression: "((MIT OR Apache-2.0) AND BSD-3-Clause) AND ISC"},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, licensesToCDX(tc.licenses))
+		})
+	}
+}
+
+func TestSerializePathRewriter(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app",
+		Occurrences: []*sbom.Occurrence{{Location: "/home/alice/src/app/main.go", Line: 10}},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "file:///home/alice/dist/app.tar.gz", Type: sbom.ExternalReference_DOWNLOAD},
+			{Url: "/home/alice/dist/app.log", Type: sbom.ExternalReference_LOG},
+			{Url: "https://example.com/home/alice/", Type: sbom.ExternalReference_WEBSITE},
+		},
+	})
+	redact := func(p string) string {
+		return strings.Replace(p, "/home/alice/", "", 1)
+	}
+
+	out, err := NewCDX("1.6", "json").Serialize(doc, &native.SerializeOptions{PathRewriter: redact}, nil)
+	require.NoError(t, err)
+	c := out.(*cdx.BOM).Metadata.Component
+	require.Equal(t, "src/app/main.go", (*c.Evidence.Occurrences)[0].Location)
+	refs := *c.ExternalReferences
+	require.Equal(t, "file://dist/app.tar.gz", refs[0].URL)
+	require.Equal(t, "dist/app.log", refs[1].URL)
+	require.Equal(t, "https://example.com/home/alice/", refs[2].URL, "remote URLs are not rewritten")
+
+	// Paths are written as is by default
+	out, err = NewCDX("1.6", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "/home/alice/src/app/main.go", (*out.(*cdx.BOM).Metadata.Component.Evidence.Occurrences)[0].Location)
+}
+
+func TestSerializeNonNumericVersion(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Version = "1.0.0-rc1"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	var degradations []native.Degradation
+	out, err := NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{Degradations: &degradations}, nil)
+	require.NoError(t, err)
+	bom := out.(*cdx.BOM)
+	require.Equal(t, 1, bom.Version)
+	require.Contains(t, *bom.Metadata.Properties, cdx.Property{
+		Name: cdxformats.PropertyDocumentVersion, Value: "1.0.0-rc1",
+	})
+	require.Equal(t, []native.Degradation{{
+		Field:   "version",
+		Message: `document version "1.0.0-rc1" is not a number, written as the default version`,
+	}}, degradations)
+
+	out, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{DisableProperties: true}, nil)
+	require.NoError(t, err)
+	require.Nil(t, out.(*cdx.BOM).Metadata.Properties)
+
+	_, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{StrictVersion: true}, nil)
+	require.Error(t, err)
+	require.Error(t, NewCDX("1.5", "json").SerializeStream(
+		context.Background(), doc, io.Discard, &native.SerializeOptions{StrictVersion: true},
+	))
+
+	// Numeric versions are written as is, even in strict mode
+	doc.Metadata.Version = "4"
+	out, err = NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{StrictVersion: true}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, out.(*cdx.BOM).Version)
+	require.Nil(t, out.(*cdx.BOM).Metadata.Properties)
+}
+
+func TestSerializeDocumentExternalReferences(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.ExternalReferences = []*sbom.ExternalReference{
+		{Url: "https://example.com/product", Type: sbom.ExternalReference_WEBSITE},
+		{Url: "https://github.com/example/app", Type: sbom.ExternalReference_VCS, Comment: "sources"},
+	}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	sut := NewCDX("1.5", "json")
+
+	for name, serialize := range map[string]func(io.Writer) error{
+		"buffered": func(wr io.Writer) error {
+			bom, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+			if err != nil {
+				return err
+			}
+			return sut.Render(bom, wr, &native.RenderOptions{}, nil)
+		},
+		"stream": func(wr io.Writer) error {
+			return sut.SerializeStream(context.Background(), doc, wr, &native.SerializeOptions{})
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, serialize(&buf))
+			require.Contains(t, buf.String(), "https://example.com/product")
+
+			bom := cdx.NewBOM()
+			require.NoError(t, cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(bom))
+			require.Equal(t, []cdx.ExternalReference{
+				{Type: cdx.ERTypeWebsite, URL: "https://example.com/product"},
+				{Type: cdx.ERTypeVCS, URL: "https://github.com/example/app", Comment: "sources"},
+			}, *bom.ExternalReferences)
+		})
+	}
+}
+
+// validateCDXSchema validates a rendered CycloneDX JSON document against
+// the schema of its version in testdata/schema.
+func validateCDXSchema(t *testing.T, version string, data []byte) {
+	t.Helper()
+	sl := gojsonschema.NewSchemaLoader()
+	for _, name := range []string{"spdx.schema.json", "jsf-0.82.schema.json"} {
+		schema, err := os.ReadFile(filepath.Join("testdata", "schema", name))
+		require.NoError(t, err)
+		require.NoError(t, sl.AddSchemas(gojsonschema.NewBytesLoader(schema)))
+	}
+	schema, err := os.ReadFile(filepath.Join("testdata", "schema", fmt.Sprintf("bom-%s.schema.json", version)))
+	require.NoError(t, err)
+	compiled, err := sl.Compile(gojsonschema.NewBytesLoader(schema))
+	require.NoError(t, err)
+
+	res, err := compiled.Validate(gojsonschema.NewBytesLoader(data))
+	require.NoError(t, err)
+	require.True(t, res.Valid(), "%v", res.Errors())
+}
+
+func TestSerializeSchemaValidation(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.Metadata.Version = "1"
+	doc.Metadata.Date = timestamppb.New(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+	doc.Metadata.Authors = []*sbom.Person{{Name: "John Doe", Email: "john@example.com"}}
+	doc.Metadata.Tools = []*sbom.Tool{{
+		Name: "syft", Version: "1.0.0", Vendor: "anchore",
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA256): strings.Repeat("a", 64)},
+	}}
+	doc.Metadata.Lifecycles = []*sbom.Lifecycle{{Phase: sbom.Lifecycle_BUILD}}
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "2.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Licenses:       []string{"Apache-2.0"},
+		Hashes:         map[int32]string{int32(sbom.HashAlgorithm_SHA1): strings.Repeat("b", 40)},
+		Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/lib@2.0.0"},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://example.com/lib", Type: sbom.ExternalReference_WEBSITE},
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}})
+
+	for _, version := range []string{"1.4", "1.5", "1.6"} {
+		t.Run(version, func(t *testing.T) {
+			sut := NewCDX(version, "json")
+			out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+			validateCDXSchema(t, version, buf.Bytes())
+
+		})
+	}
+}
+
+func TestSerializeToolsRepresentation(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Tools = []*sbom.Tool{{Name: "syft", Version: "1.0.0", Vendor: "anchore"}}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	opts := &native.SerializeOptions{AddGeneratorTool: true}
+
+	for _, tc := range []struct {
+		version    string
+		components bool
+	}{
+		{"1.3", false},
+		{"1.4", false},
+		{"1.5", true},
+		{"1.6", true},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			sut := NewCDX(tc.version, "json")
+			out, err := sut.Serialize(doc, opts, nil)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{}, nil))
+			var streamed bytes.Buffer
+			require.NoError(t, sut.SerializeStream(context.Background(), doc, &streamed, opts))
+
+			for _, data := range [][]byte{buf.Bytes(), streamed.Bytes()} {
+				bom := cdx.NewBOM()
+				require.NoError(t, cdx.NewBOMDecoder(bytes.NewReader(data), cdx.BOMFileFormatJSON).Decode(bom))
+				tools := bom.Metadata.Tools
+				if !tc.components {
+					require.Nil(t, tools.Components)
+					legacy := *tools.Tools //nolint:staticcheck
+					require.Len(t, legacy, 2)
+					require.Equal(t, "anchore", legacy[0].Vendor)
+					continue
+				}
+				require.Nil(t, tools.Tools) //nolint:staticcheck
+				require.Len(t, *tools.Components, 2)
+				for _, c := range *tools.Components {
+					require.Equal(t, cdx.ComponentTypeApplication, c.Type)
+				}
+				require.Equal(t, "anchore", (*tools.Components)[0].Publisher)
+				require.Equal(t, "protobom", (*tools.Components)[1].Name)
+			}
+		})
+	}
+}
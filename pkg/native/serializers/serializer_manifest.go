@@ -0,0 +1,64 @@
+package serializers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+)
+
+var _ native.Serializer = &Manifest{}
+
+// Manifest is a lightweight serializer that writes a flat JSON object
+// mapping the package URLs of the document nodes to their versions. It is
+// meant for tools that only need to know which packages are present.
+type Manifest struct{}
+
+func NewManifest() *Manifest {
+	return &Manifest{}
+}
+
+// Serialize returns a map of the package URLs in the document to the
+// version of the node they belong to. Alternate purls are listed too. Nodes
+// without a purl are skipped and, if more than one node has the same purl,
+// the first one wins.
+func (s *Manifest) Serialize(bom *sbom.Document, opts *native.SerializeOptions, _ interface{}) (interface{}, error) {
+	if bom == nil {
+		return nil, errors.New("document is nil, unable to serialize manifest")
+	}
+
+	manifest := map[string]string{}
+	for _, n := range bom.GetNodeList().GetNodes() {
+		for _, purl := range n.Purls() {
+			key := opts.NormalizePURL(string(purl))
+			if _, ok := manifest[key]; ok {
+				continue
+			}
+			manifest[key] = n.Version
+		}
+	}
+
+	return manifest, nil
+}
+
+// Render writes the manifest as a JSON object with its keys sorted.
+func (s *Manifest) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ interface{}) error {
+	manifest, ok := doc.(map[string]string)
+	if !ok {
+		return errors.New("unable to render manifest, document is not a purl map")
+	}
+
+	encoder := json.NewEncoder(wr)
+	if o != nil {
+		encoder.SetIndent("", strings.Repeat(" ", o.Indent))
+	}
+	if err := encoder.Encode(manifest); err != nil {
+		return fmt.Errorf("encoding manifest to stream: %w", err)
+	}
+
+	return nil
+}
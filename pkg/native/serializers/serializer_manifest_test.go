@@ -0,0 +1,55 @@
+package serializers
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/app@1.0.0"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib1", Name: "lib1", Version: "2.1.0",
+		Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:npm/lib1@2.1.0"},
+		AlternatePurls: []string{"pkg:github/example/lib1@2.1.0"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib2", Name: "lib2", Version: "0.3.0",
+		Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:golang/example.com/lib2@v0.3.0"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "nopurl", Name: "nopurl", Version: "9.9.9"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2", "nopurl"}})
+
+	sut := NewManifest()
+	out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{Indent: 2}, nil))
+
+	manifest := map[string]string{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &manifest))
+	require.Equal(t, map[string]string{
+		"pkg:generic/app@1.0.0":              "1.0.0",
+		"pkg:npm/lib1@2.1.0":                 "2.1.0",
+		"pkg:github/example/lib1@2.1.0":      "2.1.0",
+		"pkg:golang/example.com/lib2@v0.3.0": "0.3.0",
+	}, manifest)
+
+	for _, n := range doc.NodeList.Nodes {
+		for _, purl := range n.Purls() {
+			require.Equal(t, n.Version, manifest[string(purl)])
+		}
+	}
+
+	_, err = sut.Serialize(nil, nil, nil)
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	protospdx "github.com/bom-squad/protobom/pkg/formats/spdx"
 	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/sirupsen/logrus"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/v2/common"
 	"github.com/spdx/tools-golang/spdx/v2/v2_3"
@@ -34,7 +35,13 @@ func NewSPDX23() *SPDX23 {
 func (s *SPDX23) Render(doc interface{}, wr io.Writer, o *native.RenderOptions, _ interface{}) error {
 	// TODO: add support for XML
 	encoder := json.NewEncoder(wr)
-	encoder.SetIndent("", strings.Repeat(" ", o.Indent))
+	if o == nil || !o.Compact {
+		indent := 2
+		if o != nil && o.Indent > 0 {
+			indent = o.Indent
+		}
+		encoder.SetIndent("", strings.Repeat(" ", indent))
+	}
 	if err := encoder.Encode(doc.(*spdx.Document)); err != nil {
 		return fmt.Errorf("encoding sbom to stream: %w", err)
 	}
@@ -43,13 +50,46 @@ func (s *SPDX23) Render(doc interface{}, wr io.Writer, o *native.RenderOptions,
 }
 
 // Serialize takes a protobom and returns an SPDX 2.3 struct
-func (s *SPDX23) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ interface{}) (interface{}, error) {
+func (s *SPDX23) Serialize(bom *sbom.Document, opts *native.SerializeOptions, _ interface{}) (interface{}, error) {
 	if bom == nil {
 		return nil, errors.New("document is nil, unable to serialize to SPDX 2.3")
 	}
 	if bom.Metadata == nil {
 		return nil, errors.New("document metadata is nil, unable to serialize to SPDX 2.3")
 	}
+
+	// Nodes sharing an ID would be written with the same identifier
+	bom, merged, err := opts.CheckDuplicateIDs(bom)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to SPDX 2.3: %w", err)
+	}
+	if merged > 0 {
+		logrus.Warnf("merged %d nodes with duplicate IDs", merged)
+	}
+
+	// Prune a copy of the document, the original is left untouched
+	if opts != nil && opts.PruneUnreachable {
+		bom = &sbom.Document{Metadata: bom.Metadata, NodeList: bom.NodeList.Copy()}
+		if removed := bom.PruneUnreachable(); removed > 0 {
+			logrus.Infof("pruned %d unreachable nodes", removed)
+		}
+	}
+
+	// Check the size before building anything, the document may be untrusted
+	bom, truncated, err := opts.LimitComponents(bom)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize to SPDX 2.3: %w", err)
+	}
+	if truncated > 0 {
+		logrus.Warnf("document truncated to %d packages, %d left out", opts.MaxComponents, truncated)
+	}
+
+	// The creation date is required, if there is none the current time is used
+	created := time.Now()
+	if date := opts.DocumentDate(bom.Metadata); date != nil {
+		created = *date
+	}
+
 	doc := &spdx.Document{
 		SPDXVersion:       spdx.Version,
 		DataLicense:       spdx.DataLicense,
@@ -68,8 +108,7 @@ func (s *SPDX23) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ int
 				},
 			},
 
-			// Interesting, should we keep the original date?
-			Created: time.Now().UTC().Format(time.RFC3339),
+			Created: created.UTC().Format(time.RFC3339),
 			// CreatorComment: bom.Metadata.Authors(),
 			// CreatorComment: bom.Metadata.... /// TODO(puerco): Missing in the proto
 		},
@@ -93,7 +132,7 @@ func (s *SPDX23) Serialize(bom *sbom.Document, _ *native.SerializeOptions, _ int
 		})
 	}
 
-	packages, err := s.buildPackages(bom)
+	packages, err := s.buildPackages(bom, opts)
 	if err != nil {
 		return nil, fmt.Errorf("building SPDX packages: %s", err)
 	}
@@ -187,7 +226,7 @@ func buildFiles(bom *sbom.Document) ([]*spdx.File, error) { //nolint:unparam
 	return files, nil
 }
 
-func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //nolint:unparam
+func (s *SPDX23) buildPackages(bom *sbom.Document, opts *native.SerializeOptions) ([]*spdx.Package, error) { //nolint:unparam
 	packages := []*spdx.Package{}
 	for _, node := range bom.NodeList.Nodes {
 		if node.Type == sbom.Node_FILE {
@@ -327,10 +366,22 @@ func (s *SPDX23) buildPackages(bom *sbom.Document) ([]*spdx.Package, error) { //
 		}
 
 		for i := range node.Identifiers {
+			locator := node.Identifiers[i]
+			if i == int32(sbom.SoftwareIdentifierType_PURL) {
+				locator = opts.NormalizePURL(locator)
+			}
 			p.PackageExternalReferences = append(p.PackageExternalReferences, &v2_3.PackageExternalReference{
 				Category: sbom.SoftwareIdentifierType(i).ToSPDX2Category(),
 				RefType:  sbom.SoftwareIdentifierType(i).ToSPDX2Type(),
-				Locator:  node.Identifiers[i],
+				Locator:  locator,
+			})
+		}
+
+		for _, purl := range node.AlternatePurls {
+			p.PackageExternalReferences = append(p.PackageExternalReferences, &v2_3.PackageExternalReference{
+				Category: sbom.SoftwareIdentifierType_PURL.ToSPDX2Category(),
+				RefType:  sbom.SoftwareIdentifierType_PURL.ToSPDX2Type(),
+				Locator:  opts.NormalizePURL(purl),
 			})
 		}
 
@@ -1,11 +1,16 @@
 package serializers
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestExtRefCategoryFromProtobomExtRef(t *testing.T) {
@@ -62,3 +67,94 @@ func TestExtRefTypeFromProtobomExtRef(t *testing.T) {
 		})
 	}
 }
+
+func TestSPDX23JSONRequiredFields(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Name = "test-document"
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		Identifiers: map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): "pkg:generic/app@1.0.0"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file", Name: "main.go", Type: sbom.Node_FILE, Hashes: map[int32]string{
+		int32(sbom.HashAlgorithm_SHA1): "e6b1000b94e835ffd37f4c6dcbdad43f4b48a02a",
+	}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"file"}})
+
+	sut := NewSPDX23()
+	out, err := sut.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, sut.Render(out, &buf, &native.RenderOptions{Indent: 2}, nil))
+
+	var rendered struct {
+		SPDXVersion       string `json:"spdxVersion"`
+		DataLicense       string `json:"dataLicense"`
+		SPDXID            string `json:"SPDXID"`
+		Name              string `json:"name"`
+		DocumentNamespace string `json:"documentNamespace"`
+		CreationInfo      struct {
+			Created  string   `json:"created"`
+			Creators []string `json:"creators"`
+		} `json:"creationInfo"`
+		Packages []struct {
+			SPDXID           string `json:"SPDXID"`
+			Name             string `json:"name"`
+			DownloadLocation string `json:"downloadLocation"`
+		} `json:"packages"`
+		Files []struct {
+			SPDXID    string `json:"SPDXID"`
+			FileName  string `json:"fileName"`
+			Checksums []struct {
+				Algorithm string `json:"algorithm"`
+			} `json:"checksums"`
+		} `json:"files"`
+		Relationships []struct {
+			Element      string `json:"spdxElementId"`
+			Related      string `json:"relatedSpdxElement"`
+			Relationship string `json:"relationshipType"`
+		} `json:"relationships"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rendered))
+
+	require.Equal(t, "SPDX-2.3", rendered.SPDXVersion)
+	require.Equal(t, "CC0-1.0", rendered.DataLicense)
+	require.Equal(t, "SPDXRef-DOCUMENT", rendered.SPDXID)
+	require.Equal(t, "test-document", rendered.Name)
+	require.NotEmpty(t, rendered.DocumentNamespace)
+	require.NotEmpty(t, rendered.CreationInfo.Created)
+	require.NotEmpty(t, rendered.CreationInfo.Creators)
+
+	require.Len(t, rendered.Packages, 1)
+	require.Equal(t, "SPDXRef-app", rendered.Packages[0].SPDXID)
+	require.Equal(t, "app", rendered.Packages[0].Name)
+	require.NotEmpty(t, rendered.Packages[0].DownloadLocation)
+
+	require.Len(t, rendered.Files, 1)
+	require.Equal(t, "SPDXRef-file", rendered.Files[0].SPDXID)
+	require.Equal(t, "main.go", rendered.Files[0].FileName)
+	require.NotEmpty(t, rendered.Files[0].Checksums)
+
+	relationships := map[string]string{}
+	for _, r := range rendered.Relationships {
+		relationships[r.Element+" "+r.Relationship] = r.Related
+	}
+	require.Equal(t, "SPDXRef-app", relationships["SPDXRef-DOCUMENT DESCRIBES"])
+	require.Equal(t, "SPDXRef-file", relationships["SPDXRef-app CONTAINS"])
+}
+
+func TestSPDX23Created(t *testing.T) {
+	fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	doc := sbom.NewDocument()
+	doc.Metadata.Date = timestamppb.New(time.Date(2020, 6, 7, 8, 9, 10, 0, time.UTC))
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	out, err := NewSPDX23().Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2020-06-07T08:09:10Z", out.(*spdx.Document).CreationInfo.Created)
+
+	out, err = NewSPDX23().Serialize(doc, &native.SerializeOptions{Timestamp: &fixed}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02T03:04:05Z", out.(*spdx.Document).CreationInfo.Created)
+}
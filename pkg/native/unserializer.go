@@ -3,7 +3,9 @@ package native
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 
 import (
+	"fmt"
 	"io"
+	"slices"
 
 	"github.com/bom-squad/protobom/pkg/sbom"
 )
@@ -13,4 +15,94 @@ type Unserializer interface {
 	Unserialize(io.Reader, *UnserializeOptions, interface{}) (*sbom.Document, error)
 }
 
-type UnserializeOptions struct{}
+// DedupStrategy selects how the unserializers detect duplicate nodes in the
+// parsed documents.
+type DedupStrategy string
+
+const (
+	// DedupNone keeps all the nodes of the document as they were read.
+	DedupNone DedupStrategy = ""
+
+	// DedupByPURL merges the nodes that have the same package URL.
+	DedupByPURL DedupStrategy = "purl"
+
+	// DedupByContentHash merges the nodes that have the same value for
+	// any of their hash algorithms. A node whose hashes match more than one
+	// node is merged into the one matching its lowest algorithm.
+	DedupByContentHash DedupStrategy = "content-hash"
+)
+
+type UnserializeOptions struct {
+	// DedupStrategy controls how duplicate nodes are merged when a document
+	// is read. Noisy scanners often list the same package more than once
+	// under different identifiers. Merged nodes are unioned: the hashes,
+	// licenses and edges of the duplicates are kept in the surviving node.
+	DedupStrategy DedupStrategy
+
+	// NameVersionSeparators splits the names of the nodes read without a
+	// version that hold one, eg "foo@1.2.3" with "@" or "foo:1.2.3" with
+	// ":". Any of the characters in the string is taken as a separator.
+	// Names are not split by default.
+	NameVersionSeparators string
+
+	// PropertyPrefix is the namespace of the properties preserving data
+	// without a native field in the input format, when the document was
+	// written with a custom one (see SerializeOptions.PropertyPrefix). The
+	// protobom: namespace is read when empty.
+	PropertyPrefix string
+}
+
+// SplitNameVersions splits the names of the nodes of doc that hold their
+// version using the configured separators. It returns the number of nodes
+// changed and is safe to call on a nil receiver.
+func (o *UnserializeOptions) SplitNameVersions(doc *sbom.Document) int {
+	if o == nil || o.NameVersionSeparators == "" || doc.GetNodeList() == nil {
+		return 0
+	}
+	count := 0
+	for _, n := range doc.NodeList.Nodes {
+		if n.SplitNameVersion(o.NameVersionSeparators) {
+			count++
+		}
+	}
+	return count
+}
+
+// DedupNodes merges the duplicate nodes of doc according to the configured
+// strategy and returns the number of nodes removed. It is safe to call on a
+// nil receiver.
+func (o *UnserializeOptions) DedupNodes(doc *sbom.Document) (int, error) {
+	if o == nil {
+		return 0, nil
+	}
+
+	switch o.DedupStrategy {
+	case DedupNone:
+		return 0, nil
+	case DedupByPURL:
+		return doc.MergeDuplicateNodes(func(n *sbom.Node) []string {
+			return []string{string(n.Purl())}
+		}), nil
+	case DedupByContentHash:
+		return doc.MergeDuplicateNodes(func(n *sbom.Node) []string {
+			// The keys are sorted by algorithm so a node matching two
+			// different nodes is always merged into the same one
+			algos := make([]int32, 0, len(n.Hashes))
+			for algo := range n.Hashes {
+				algos = append(algos, algo)
+			}
+			slices.Sort(algos)
+
+			keys := []string{}
+			for _, algo := range algos {
+				if n.Hashes[algo] == "" {
+					continue
+				}
+				keys = append(keys, fmt.Sprintf("%d:%s", algo, n.Hashes[algo]))
+			}
+			return keys
+		}), nil
+	default:
+		return 0, fmt.Errorf("unknown dedup strategy %q", o.DedupStrategy)
+	}
+}
@@ -1,13 +1,23 @@
 package unserializers
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bom-squad/protobom/pkg/native"
@@ -30,21 +40,17 @@ func NewCDX(version, encoding string) *CDX {
 
 // Unserialize reads datq data from io.Reader r and parses it as a CycloneDX
 // document. If successful returns a protobom Document loaded with the SBOM data.
-func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
-	bom := new(cdx.BOM)
-
+//
+// JSON documents are streamed: the top level components are converted to
+// nodes as they are read, so the full CycloneDX component list is never
+// loaded in memory.
+func (u *CDX) Unserialize(r io.Reader, opts *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
 	encoding, err := cdxformats.ParseEncoding(u.encoding)
 	if err != nil {
 		return nil, err
 	}
-	decoder := cdx.NewBOMDecoder(r, encoding)
-	if err := decoder.Decode(bom); err != nil {
-		return nil, fmt.Errorf("decoding cyclonedx: %w", err)
-	}
 
 	md := &sbom.Metadata{
-		Id:      bom.SerialNumber,
-		Version: fmt.Sprintf("%d", bom.Version),
 		Date:    &timestamppb.Timestamp{},
 		Tools:   []*sbom.Tool{},
 		Authors: []*sbom.Person{},
@@ -57,55 +63,612 @@ func (u *CDX) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface
 
 	cc := 0
 
-	if bom.Metadata != nil {
-		if bom.Metadata.Lifecycles != nil {
-			for _, lc := range *bom.Metadata.Lifecycles {
-				lc := lc
-				name := lc.Name
-				desc := lc.Description
-				t := u.phaseToSBOMType(&lc.Phase)
-				if name == "" {
-					name = string(lc.Phase)
-				}
+	// Relationships stored in properties and the pedigree patches are
+	// added once all nodes are read
+	propertyEdges := []*sbom.Edge{}
+	patches := []componentPatch{}
 
-				md.DocumentTypes = append(md.DocumentTypes, &sbom.DocumentType{
-					Name:        &name,
-					Description: &desc,
-					Type:        t,
-				})
-			}
+	// Documents written with a custom property namespace are moved back
+	// to the protobom one before reading the properties
+	prefix := cdxformats.PropertyPrefix
+	if opts != nil && opts.PropertyPrefix != "" {
+		prefix = opts.PropertyPrefix
+	}
+
+	onMetadata := func(m *cdx.Metadata) error {
+		cdxformats.RebaseMetadataProperties(m, prefix, cdxformats.PropertyPrefix)
+		if m.Component != nil {
+			propertyEdges = append(propertyEdges, propertiesToEdges(m.Component)...)
+			patches = append(patches, componentPatches(m.Component)...)
 		}
-		if bom.Metadata.Component != nil {
-			nl, err := u.componentToNodeList(bom.Metadata.Component, &cc)
+		return u.metadataToProtobom(doc, m, &cc)
+	}
+
+	// The graph fragments of the components are related to the root
+	// once the whole document is read, the metadata may come last.
+	fragments := []*sbom.NodeList{}
+	onComponent := func(c *cdx.Component) error {
+		cdxformats.RebaseComponentProperties(c, prefix, cdxformats.PropertyPrefix)
+		propertyEdges = append(propertyEdges, propertiesToEdges(c)...)
+		patches = append(patches, componentPatches(c)...)
+		for _, c := range ungroupComponents(&[]cdx.Component{*c}) {
+			nl, err := u.componentToNodeList(c, &cc)
 			if err != nil {
-				return nil, fmt.Errorf("converting main bom component to node: %w", err)
+				return fmt.Errorf("converting component to node: %w", err)
 			}
-			if len(nl.RootElements) > 1 {
-				logrus.Warnf("root nodelist has %d components, this should not happen", len(nl.RootElements))
+			fragments = append(fragments, nl)
+		}
+		return nil
+	}
+
+	// The input is hashed as it is read to record its digest
+	hash := sha256.New()
+	source := io.TeeReader(r, hash)
+	r = skipByteOrderMark(source)
+
+	var bom *cdx.BOM
+	if encoding == cdx.BOMFileFormatJSON {
+		bom, err = decodeJSONStream(r, onMetadata, onComponent)
+		if err != nil {
+			return nil, fmt.Errorf("decoding cyclonedx: %w", err)
+		}
+	} else {
+		bom = new(cdx.BOM)
+		decoder := cdx.NewBOMDecoder(r, encoding)
+		if err := decoder.Decode(bom); err != nil {
+			return nil, fmt.Errorf("decoding cyclonedx: %w", err)
+		}
+		if bom.Metadata != nil {
+			if err := onMetadata(bom.Metadata); err != nil {
+				return nil, err
+			}
+		}
+		if bom.Components != nil {
+			for i := range *bom.Components {
+				if err := onComponent(&(*bom.Components)[i]); err != nil {
+					return nil, err
+				}
 			}
+		}
+	}
+
+	// The decoders stop at the end of the document, hash what is left
+	if _, err := io.Copy(io.Discard, source); err != nil {
+		return nil, fmt.Errorf("reading cyclonedx: %w", err)
+	}
+	md.SourceSha256 = hex.EncodeToString(hash.Sum(nil))
+
+	md.Id = bom.SerialNumber
+	// Versions that are not a number are read back from their property
+	if md.Version == "" {
+		md.Version = fmt.Sprintf("%d", bom.Version)
+	}
+	md.SourceFormat = u.sourceFormat(bom.SpecVersion)
+	if bom.ExternalReferences != nil {
+		cdxformats.RebaseExternalReferences(bom.ExternalReferences, prefix, cdxformats.PropertyPrefix)
+		md.ExternalReferences = u.unserializeExternalReferences(bom.ExternalReferences)
+	}
+
+	// Cycle all components and get their graph fragments. Once there is a
+	// root, the fragments are related to it in a single batch to avoid
+	// reindexing the nodelist for each component.
+	batch := &sbom.NodeList{}
+	seen := map[string]struct{}{}
+	for _, nl := range fragments {
+		if len(doc.NodeList.RootElements) == 0 {
 			doc.NodeList.Add(nl)
+			continue
+		}
+		batch.RootElements = append(batch.RootElements, nl.RootElements...)
+		batch.Edges = append(batch.Edges, nl.Edges...)
+		for _, n := range nl.Nodes {
+			if _, ok := seen[n.Id]; ok {
+				continue
+			}
+			seen[n.Id] = struct{}{}
+			batch.Nodes = append(batch.Nodes, n)
+		}
+	}
+	if len(batch.RootElements) > 0 || len(batch.Nodes) > 0 {
+		if err := doc.NodeList.RelateNodeListAtID(batch, doc.NodeList.RootElements[0], sbom.Edge_contains); err != nil {
+			return nil, fmt.Errorf("relating components to root node: %w", err)
+		}
+	}
+
+	if bom.Dependencies != nil {
+		u.dependenciesToEdges(doc.NodeList, bom.Dependencies)
+	}
+	u.addPropertyEdges(doc.NodeList, propertyEdges)
+	u.addPatchNodes(doc.NodeList, patches)
+
+	// TODO(degradation): Vulnerabilities (including the VEX analysis of each
+	// one and the version ranges and statuses of the affected components) are
+	// not read. protobom does not model vulnerability data, it is a non-goal
+	// of the project (see docs/format-translation-library-proposal.md).
+	if bom.Vulnerabilities != nil && len(*bom.Vulnerabilities) > 0 {
+		logrus.Warnf("document has %d vulnerabilities, data will be lost", len(*bom.Vulnerabilities))
+	}
+
+	opts.SplitNameVersions(doc)
+
+	if _, err := opts.DedupNodes(doc); err != nil {
+		return nil, fmt.Errorf("merging duplicate nodes: %w", err)
+	}
+
+	return doc, nil
+}
+
+// sourceFormat returns the format of the document parsed. The version
+// declared in the document is preferred over the unserializer's.
+func (u *CDX) sourceFormat(specVersion cdx.SpecVersion) string {
+	version := u.version
+	if specVersion != 0 {
+		version = specVersion.String()
+	}
+	return fmt.Sprintf("application/vnd.cyclonedx+%s;version=%s", u.encoding, version)
+}
+
+// metadataToProtobom reads the CDX metadata into the document. The metadata
+// component is added to the document nodelist as its root.
+func (u *CDX) metadataToProtobom(doc *sbom.Document, m *cdx.Metadata, cc *int) error {
+	md := doc.Metadata
+	if m.Properties != nil {
+		for _, p := range *m.Properties {
+			if p.Name == cdxformats.PropertyDocumentVersion {
+				md.Version = p.Value
+			}
+		}
+	}
+
+	if m.Lifecycles != nil {
+		otherTypes := map[string]struct{}{}
+		if m.Properties != nil {
+			for _, p := range *m.Properties {
+				if p.Name == cdxformats.PropertyDocumentTypeOther {
+					otherTypes[p.Value] = struct{}{}
+				}
+			}
+		}
+
+		for _, lc := range *m.Lifecycles {
+			lc := lc
+			name := lc.Name
+			desc := lc.Description
+			t := u.phaseToSBOMType(&lc.Phase)
+			if name == "" {
+				name = string(lc.Phase)
+			}
+
+			// Phases unknown to CDX and the named lifecycles written from
+			// OTHER document types are read back as OTHER
+			if _, ok := otherTypes[lc.Name]; t == nil && (lc.Phase != "" || (ok && lc.Name != "")) {
+				t = sbom.DocumentType_OTHER.Enum()
+			}
+
+			md.DocumentTypes = append(md.DocumentTypes, &sbom.DocumentType{
+				Name:        &name,
+				Description: &desc,
+				Type:        t,
+			})
+
+			lifecycle := &sbom.Lifecycle{
+				Phase:       u.phaseToLifecyclePhase(lc.Phase),
+				Name:        lc.Name,
+				Description: lc.Description,
+			}
+			if lifecycle.Phase == sbom.Lifecycle_CUSTOM {
+				lifecycle.Name = name
+			}
+			md.Lifecycles = append(md.Lifecycles, lifecycle)
+		}
+	}
+	if m.Tools != nil {
+		md.Tools = u.toolsToProtobom(m.Tools)
+	}
+	if m.Authors != nil {
+		for _, a := range *m.Authors {
+			md.Authors = append(md.Authors, &sbom.Person{
+				Name:  a.Name,
+				Email: a.Email,
+				Phone: a.Phone,
+			})
+		}
+	}
+	if m.Supplier != nil {
+		md.Suppliers = append(md.Suppliers, u.organizationalEntityToPerson(m.Supplier))
+	}
+	if m.Manufacture != nil { //nolint:staticcheck // Replaced in 1.6 but still valid
+		md.Manufacturers = append(md.Manufacturers, u.organizationalEntityToPerson(m.Manufacture)) //nolint:staticcheck
+	}
+	if m.Component != nil {
+		nl, err := u.componentToNodeList(m.Component, cc)
+		if err != nil {
+			return fmt.Errorf("converting main bom component to node: %w", err)
+		}
+		if len(nl.RootElements) > 1 {
+			logrus.Warnf("root nodelist has %d components, this should not happen", len(nl.RootElements))
 		}
+		doc.NodeList.Add(nl)
+	}
+	return nil
+}
+
+// skipByteOrderMark returns a reader with the leading byte order mark of r
+// removed. Some tools prefix their output with one, which the decoders
+// reject. UTF-16 documents are transcoded to UTF-8.
+func skipByteOrderMark(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	// Read errors are left for the decoders to report
+	lead, _ := br.Peek(3) //nolint:errcheck
+
+	switch {
+	case bytes.HasPrefix(lead, []byte{0xEF, 0xBB, 0xBF}):
+		br.Discard(3) //nolint:errcheck,gosec // The bytes are already buffered
+		return br
+	case bytes.HasPrefix(lead, []byte{0xFE, 0xFF}):
+		return transform.NewReader(br, unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM).NewDecoder())
+	case bytes.HasPrefix(lead, []byte{0xFF, 0xFE}):
+		return transform.NewReader(br, unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM).NewDecoder())
+	default:
+		return br
+	}
+}
+
+// decodeJSONStream reads a CycloneDX JSON document from r token by token.
+// The metadata and each of the top level components are passed to the
+// callbacks as soon as they are decoded and are not stored in the returned
+// BOM, which holds the rest of the document.
+func decodeJSONStream(r io.Reader, onMetadata func(*cdx.Metadata) error, onComponent func(*cdx.Component) error) (*cdx.BOM, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
 	}
 
-	// Cycle all components and get their graph fragments
-	if bom.Components != nil {
-		for i := range *bom.Components {
-			nl, err := u.componentToNodeList(&(*bom.Components)[i], &cc)
+	rest := map[string]json.RawMessage{}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v", t)
+		}
+
+		switch key {
+		case "metadata":
+			m := &cdx.Metadata{}
+			if err := dec.Decode(m); err != nil {
+				return nil, fmt.Errorf("decoding metadata: %w", err)
+			}
+			if err := onMetadata(m); err != nil {
+				return nil, err
+			}
+		case "components":
+			t, err := dec.Token()
 			if err != nil {
-				return nil, fmt.Errorf("converting component to node: %w", err)
+				return nil, err
+			}
+			// null components
+			if t == nil {
+				continue
+			}
+			if d, ok := t.(json.Delim); !ok || d != '[' {
+				return nil, fmt.Errorf("components is not an array")
+			}
+			for dec.More() {
+				c := &cdx.Component{}
+				if err := dec.Decode(c); err != nil {
+					return nil, fmt.Errorf("decoding component: %w", err)
+				}
+				if err := onComponent(c); err != nil {
+					return nil, err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return nil, err
+			}
+		default:
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, fmt.Errorf("decoding %s: %w", key, err)
+			}
+			rest[key] = raw
+		}
+	}
+
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+
+	// The rest of the document is small, decode it in one go
+	data, err := json.Marshal(rest)
+	if err != nil {
+		return nil, err
+	}
+	bom := new(cdx.BOM)
+	if err := json.Unmarshal(data, bom); err != nil {
+		return nil, err
+	}
+	return bom, nil
+}
+
+// expectDelim reads the next token from the decoder and checks it is delim
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %q, got %v", delim, t)
+	}
+	return nil
+}
+
+// toolsToProtobom reads the tools from the CDX metadata. Both the legacy
+// tools list and the tool components used since CycloneDX 1.5 are read.
+func (u *CDX) toolsToProtobom(tools *cdx.ToolsChoice) []*sbom.Tool {
+	ret := []*sbom.Tool{}
+	if tools.Tools != nil { //nolint:staticcheck // Tool is needed for older cdx versions
+		for _, t := range *tools.Tools { //nolint:staticcheck // Tool is needed for older cdx versions
+			tool := &sbom.Tool{
+				Name:    t.Name,
+				Version: t.Version,
+				Vendor:  t.Vendor,
+			}
+			if t.Hashes != nil {
+				tool.Hashes = u.hashesToProtobom(t.Hashes)
+			}
+			if t.ExternalReferences != nil {
+				tool.ExternalReferences = u.unserializeExternalReferences(t.ExternalReferences)
+			}
+			ret = append(ret, tool)
+		}
+	}
+
+	if tools.Components != nil {
+		for _, c := range *tools.Components {
+			tool := &sbom.Tool{
+				Name:    c.Name,
+				Version: c.Version,
+				Vendor:  c.Publisher,
+			}
+			if c.Hashes != nil {
+				tool.Hashes = u.hashesToProtobom(c.Hashes)
+			}
+			if c.ExternalReferences != nil {
+				tool.ExternalReferences = u.unserializeExternalReferences(c.ExternalReferences)
+			}
+			ret = append(ret, tool)
+		}
+	}
+	return ret
+}
+
+// propertiesToEdges returns the relationships recorded in the properties of
+// a component and its subcomponents.
+func propertiesToEdges(c *cdx.Component) []*sbom.Edge {
+	edges := []*sbom.Edge{}
+	if c.Properties != nil {
+		for _, p := range *c.Properties {
+			if !strings.HasPrefix(p.Name, cdxformats.PropertyEdgePrefix) {
+				continue
+			}
+			typeName := strings.TrimPrefix(p.Name, cdxformats.PropertyEdgePrefix)
+			t, ok := sbom.Edge_Type_value[typeName]
+			if !ok {
+				logrus.Warnf("component %s: unknown relationship type %q", c.BOMRef, typeName)
+				continue
+			}
+			edges = append(edges, &sbom.Edge{Type: sbom.Edge_Type(t), From: c.BOMRef, To: []string{p.Value}})
+		}
+	}
+	if c.Components != nil {
+		for i := range *c.Components {
+			edges = append(edges, propertiesToEdges(&(*c.Components)[i])...)
+		}
+	}
+	return edges
+}
+
+// componentPatch is a patch in the pedigree of a component
+type componentPatch struct {
+	ref   string
+	patch cdx.Patch
+}
+
+// componentPatches returns the pedigree patches of a component and its
+// subcomponents.
+func componentPatches(c *cdx.Component) []componentPatch {
+	patches := []componentPatch{}
+	if c.Pedigree != nil && c.Pedigree.Patches != nil {
+		for _, p := range *c.Pedigree.Patches {
+			patches = append(patches, componentPatch{ref: c.BOMRef, patch: p})
+		}
+	}
+	if c.Components != nil {
+		for i := range *c.Components {
+			patches = append(patches, componentPatches(&(*c.Components)[i])...)
+		}
+	}
+	return patches
+}
+
+// addPatchNodes adds a node for each pedigree patch, related to the
+// patched node with a patch edge. Patches already in the nodelist, as
+// written by protobom from a patch node with the diff URL among its
+// external references, are skipped.
+func (u *CDX) addPatchNodes(nl *sbom.NodeList, patches []componentPatch) {
+	if len(patches) == 0 {
+		return
+	}
+	nodes := map[string]*sbom.Node{}
+	for _, n := range nl.Nodes {
+		nodes[n.Id] = n
+	}
+	patchedBy := map[string][]*sbom.Node{}
+	for _, e := range nl.Edges {
+		if e.Type != sbom.Edge_patch {
+			continue
+		}
+		for _, to := range e.To {
+			if n, ok := nodes[e.From]; ok {
+				patchedBy[to] = append(patchedBy[to], n)
+			}
+		}
+	}
+
+	count := map[string]int{}
+	for _, cp := range patches {
+		if _, ok := nodes[cp.ref]; !ok {
+			logrus.Warnf("patch references unknown component %s", cp.ref)
+			continue
+		}
+		diffURL := ""
+		if cp.patch.Diff != nil {
+			diffURL = cp.patch.Diff.URL
+		}
+		if patchRecorded(patchedBy[cp.ref], diffURL) {
+			continue
+		}
+
+		count[cp.ref]++
+		n := &sbom.Node{
+			Id:             fmt.Sprintf("%s-patch-%d", cp.ref, count[cp.ref]),
+			Type:           sbom.Node_PACKAGE,
+			Name:           fmt.Sprintf("%s patch", cp.patch.Type),
+			PrimaryPurpose: []sbom.Purpose{sbom.Purpose_PATCH},
+		}
+		if diffURL != "" {
+			n.ExternalReferences = append(n.ExternalReferences, &sbom.ExternalReference{
+				Url: diffURL, Type: sbom.ExternalReference_DOWNLOAD,
+			})
+		}
+		if cp.patch.Diff != nil && cp.patch.Diff.Text != nil {
+			logrus.Warnf("component %s: patch diff text dropped", cp.ref)
+		}
+		if cp.patch.Resolves != nil {
+			for _, issue := range *cp.patch.Resolves {
+				n.ExternalReferences = append(n.ExternalReferences, issueToExternalReferences(issue)...)
+			}
+		}
+		nl.AddNode(n)
+		nl.AddEdge(&sbom.Edge{Type: sbom.Edge_patch, From: n.Id, To: []string{cp.ref}})
+	}
+}
+
+// patchRecorded returns true if one of the patch nodes has an external
+// reference to the diff of a patch. Patches without a diff are recorded
+// if the component has any patch node.
+func patchRecorded(patchNodes []*sbom.Node, diffURL string) bool {
+	if diffURL == "" {
+		return len(patchNodes) > 0
+	}
+	for _, n := range patchNodes {
+		for _, er := range n.GetExternalReferences() {
+			if er.GetUrl() == diffURL {
+				return true
 			}
+		}
+	}
+	return false
+}
+
+// issueToExternalReferences returns an external reference for each of the
+// references of an issue resolved by a patch, with the issue ID as comment.
+func issueToExternalReferences(issue cdx.Issue) []*sbom.ExternalReference {
+	t := sbom.ExternalReference_ISSUE_TRACKER
+	if issue.Type == cdx.IssueTypeSecurity {
+		t = sbom.ExternalReference_SECURITY_ADVISORY
+	}
+	refs := []*sbom.ExternalReference{}
+	if issue.References != nil {
+		for _, url := range *issue.References {
+			refs = append(refs, &sbom.ExternalReference{Url: url, Type: t, Comment: issue.ID})
+		}
+	}
+	return refs
+}
+
+// addPropertyEdges adds the relationships read from the component properties
+// to the nodelist. Edges between unknown components are skipped.
+func (u *CDX) addPropertyEdges(nl *sbom.NodeList, edges []*sbom.Edge) {
+	if len(edges) == 0 {
+		return
+	}
+	ids := map[string]struct{}{}
+	for _, n := range nl.Nodes {
+		ids[n.Id] = struct{}{}
+	}
+
+	// Group the targets by source and type, one edge each
+	grouped := []*sbom.Edge{}
+	index := map[string]*sbom.Edge{}
+	for _, e := range edges {
+		_, fromOK := ids[e.From]
+		_, toOK := ids[e.To[0]]
+		if !fromOK || !toOK {
+			logrus.Warnf("%s relationship references unknown component", e.Type)
+			continue
+		}
+		key := e.From + "+++" + e.Type.String()
+		if _, ok := index[key]; !ok {
+			index[key] = &sbom.Edge{Type: e.Type, From: e.From, To: []string{}}
+			grouped = append(grouped, index[key])
+		}
+		if !slices.Contains(index[key].To, e.To[0]) {
+			index[key].To = append(index[key].To, e.To[0])
+		}
+	}
 
-			if len(doc.NodeList.RootElements) == 0 {
-				doc.NodeList.Add(nl)
-			} else {
-				if err := doc.NodeList.RelateNodeListAtID(nl, doc.NodeList.RootElements[0], sbom.Edge_contains); err != nil {
-					return nil, fmt.Errorf("relating components to root node: %w", err)
+	for _, e := range grouped {
+		if existing := nl.GetEdgeByType(e.From, e.Type); existing != nil {
+			for _, to := range e.To {
+				if !slices.Contains(existing.To, to) {
+					existing.To = append(existing.To, to)
 				}
 			}
+			continue
 		}
+		nl.AddEdge(e)
 	}
+}
 
-	return doc, nil
+// dependenciesToEdges adds the CDX dependency graph to the nodelist as
+// dependsOn edges. References to unknown components are skipped.
+func (u *CDX) dependenciesToEdges(nl *sbom.NodeList, deps *[]cdx.Dependency) {
+	ids := map[string]struct{}{}
+	for _, n := range nl.Nodes {
+		ids[n.Id] = struct{}{}
+	}
+
+	for _, d := range *deps {
+		if d.Dependencies == nil || len(*d.Dependencies) == 0 {
+			continue
+		}
+
+		if _, ok := ids[d.Ref]; !ok {
+			logrus.Warnf("dependency graph references unknown component %q", d.Ref)
+			continue
+		}
+
+		edge := &sbom.Edge{
+			Type: sbom.Edge_dependsOn,
+			From: d.Ref,
+			To:   []string{},
+		}
+		for _, ref := range *d.Dependencies {
+			if _, ok := ids[ref]; !ok {
+				logrus.Warnf("dependency graph references unknown component %q", ref)
+				continue
+			}
+			edge.To = append(edge.To, ref)
+		}
+
+		if len(edge.To) > 0 {
+			nl.AddEdge(edge)
+		}
+	}
 }
 
 // componentToNodes takes a CycloneDX component and computes its graph fragment,
@@ -123,8 +686,8 @@ func (u *CDX) componentToNodeList(component *cdx.Component, cc *int) (*sbom.Node
 	}
 
 	if component.Components != nil {
-		for i := range *component.Components {
-			subList, err := u.componentToNodeList(&(*component.Components)[i], cc)
+		for _, sub := range ungroupComponents(component.Components) {
+			subList, err := u.componentToNodeList(sub, cc)
 			if err != nil {
 				return nil, fmt.Errorf("converting subcomponent to nodelist: %w", err)
 			}
@@ -137,6 +700,36 @@ func (u *CDX) componentToNodeList(component *cdx.Component, cc *int) (*sbom.Node
 	return nl, nil
 }
 
+// ungroupComponents returns a list of components replacing the synthetic
+// groups written by the protobom serializer with the components they contain.
+func ungroupComponents(comps *[]cdx.Component) []*cdx.Component {
+	ret := []*cdx.Component{}
+	for i := range *comps {
+		c := &(*comps)[i]
+		if !isGroupComponent(c) {
+			ret = append(ret, c)
+			continue
+		}
+		if c.Components != nil {
+			ret = append(ret, ungroupComponents(c.Components)...)
+		}
+	}
+	return ret
+}
+
+// isGroupComponent returns true if the component is a synthetic group
+func isGroupComponent(c *cdx.Component) bool {
+	if c.Properties == nil {
+		return false
+	}
+	for _, p := range *c.Properties {
+		if p.Name == cdxformats.PropertyGroup {
+			return true
+		}
+	}
+	return false
+}
+
 func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { //nolint:unparam
 	(*cc)++
 	node := &sbom.Node{
@@ -152,8 +745,9 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 		Hashes:             map[int32]string{},
 		Description:        c.Description,
 		Attribution:        []string{},
-		Suppliers:          []*sbom.Person{}, // TODO
-		Originators:        []*sbom.Person{}, // TODO
+		Suppliers:          []*sbom.Person{},
+		Originators:        []*sbom.Person{},
+		Manufacturers:      []*sbom.Person{},
 		ExternalReferences: []*sbom.ExternalReference{},
 		Identifiers:        map[int32]string{},
 		FileTypes:          []string{},
@@ -182,20 +776,132 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 		node.Identifiers[int32(sbom.SoftwareIdentifierType_PURL)] = c.PackageURL
 	}
 
-	if c.Hashes != nil {
-		for _, h := range *c.Hashes {
-			algo := sbom.HashAlgorithmFromCDX(h.Algorithm)
-			if algo == sbom.HashAlgorithm_UNKNOWN {
-				// TODO(degradation): Well, not deprecation but invalid SBOM
-				continue
+	// CDX 1.6 native identifier lists. protobom holds one identifier of
+	// each type, so only the first one is kept.
+	for idType, ids := range map[sbom.SoftwareIdentifierType]*[]string{
+		sbom.SoftwareIdentifierType_GITOID: c.OmniborID,
+		sbom.SoftwareIdentifierType_SWHID:  c.SWHID,
+	} {
+		if ids == nil || len(*ids) == 0 {
+			continue
+		}
+		// TODO(degradation): Identifiers after the first one are lost
+		if len(*ids) > 1 {
+			logrus.Warnf("component %s has %d %s identifiers, only the first one is kept", c.BOMRef, len(*ids), idType)
+		}
+		node.Identifiers[int32(idType)] = (*ids)[0]
+	}
+
+	// The evidence occurrences, copyright and licenses are read into the
+	// node. The rest of the evidence is not.
+	// TODO(degradation): protobom has no model for the identity evidence,
+	// and the cyclonedx-go types do not support the concludedValue added in
+	// CycloneDX 1.6 yet. They also hold a single identity, while 1.6 allows
+	// one per identifier field.
+	if c.Evidence != nil && c.Evidence.Occurrences != nil {
+		for _, o := range *c.Evidence.Occurrences {
+			node.Occurrences = append(node.Occurrences, u.occurrenceToProtobom(o))
+		}
+	}
+
+	// Copyright and licenses found as evidence complete the native fields
+	if c.Evidence != nil {
+		if node.Copyright == "" && c.Evidence.Copyright != nil {
+			texts := []string{}
+			for _, cr := range *c.Evidence.Copyright {
+				if cr.Text != "" {
+					texts = append(texts, cr.Text)
+				}
 			}
+			node.Copyright = strings.Join(texts, "\n")
+		}
+		for _, l := range u.licenseChoicesToLicenseList(c.Evidence.Licenses) {
+			if !slices.Contains(node.Licenses, l) {
+				node.Licenses = append(node.Licenses, l)
+			}
+		}
+	}
+
+	// The suppliers after the first one may be stored in properties
+	extraSuppliers := []*sbom.Person{}
 
-			if _, ok := node.Hashes[int32(algo)]; ok {
-				// TODO(degradation): Data loss from two hashes of the same algorithm
+	// Identifiers without a native CDX field are stored in properties. Any
+	// property outside of the protobom namespace, or carrying build
+	// metadata, is kept in the node.
+	if c.Properties != nil {
+		for _, p := range *c.Properties {
+			if !strings.HasPrefix(p.Name, cdxformats.PropertyPrefix) ||
+				strings.HasPrefix(p.Name, cdxformats.PropertyBuildPrefix) {
+				node.Properties = append(node.Properties, &sbom.Property{Name: p.Name, Value: p.Value})
+				continue
+			}
+			if p.Name == cdxformats.PropertySupplier {
+				oe := &cdx.OrganizationalEntity{}
+				if err := json.Unmarshal([]byte(p.Value), oe); err != nil {
+					logrus.Warnf("component %s: unable to parse supplier property: %v", c.BOMRef, err)
+					continue
+				}
+				extraSuppliers = append(extraSuppliers, u.organizationalEntityToPerson(oe))
+				continue
+			}
+			if p.Name == cdxformats.PropertyValidUntil {
+				validUntil, err := time.Parse(time.RFC3339, p.Value)
+				if err != nil {
+					logrus.Warnf("component %s: unable to parse valid until date: %v", c.BOMRef, err)
+					continue
+				}
+				node.ValidUntilDate = timestamppb.New(validUntil)
+				continue
+			}
+			if !strings.HasPrefix(p.Name, cdxformats.PropertyIdentifierPrefix) {
 				continue
 			}
-			node.Hashes[int32(algo)] = h.Value
+			idName := strings.ToUpper(strings.TrimPrefix(p.Name, cdxformats.PropertyIdentifierPrefix))
+			idType, ok := sbom.SoftwareIdentifierType_value[idName]
+			if !ok {
+				// Types unknown to the writer are stored with their raw key
+				rawType, err := strconv.ParseInt(idName, 10, 32)
+				if err != nil {
+					continue
+				}
+				idType = int32(rawType)
+			}
+			// A component can have more than one purl, the extra ones
+			// are alternates of the one in the purl field
+			if _, ok := node.Identifiers[idType]; ok && idType == int32(sbom.SoftwareIdentifierType_PURL) {
+				node.AlternatePurls = append(node.AlternatePurls, p.Value)
+				continue
+			}
+			node.Identifiers[idType] = p.Value
+		}
+	}
+
+	node.Hashes = u.hashesToProtobom(c.Hashes)
+
+	if c.ReleaseNotes != nil {
+		node.ReleaseNotes = u.releaseNotesToProtobom(c.ReleaseNotes)
+	}
+
+	if c.Supplier != nil {
+		node.Suppliers = append(node.Suppliers, u.organizationalEntityToPerson(c.Supplier))
+	}
+	node.Suppliers = append(node.Suppliers, extraSuppliers...)
+
+	if c.Manufacturer != nil {
+		node.Manufacturers = append(node.Manufacturers, u.organizationalEntityToPerson(c.Manufacturer))
+	}
+
+	// Read the authors list, falling back to the deprecated author string
+	if c.Authors != nil {
+		for _, a := range *c.Authors {
+			node.Originators = append(node.Originators, &sbom.Person{
+				Name:  a.Name,
+				Email: a.Email,
+				Phone: a.Phone,
+			})
 		}
+	} else if c.Author != "" {
+		node.Originators = append(node.Originators, &sbom.Person{Name: c.Author})
 	}
 
 	// Generate a new ID if none is set
@@ -206,6 +912,101 @@ func (u *CDX) componentToNode(c *cdx.Component, cc *int) (*sbom.Node, error) { /
 	return node, nil
 }
 
+// occurrenceToProtobom converts a CDX evidence occurrence to a node occurrence
+func (u *CDX) occurrenceToProtobom(o cdx.EvidenceOccurrence) *sbom.Occurrence {
+	occ := &sbom.Occurrence{
+		Location:          o.Location,
+		Symbol:            o.Symbol,
+		AdditionalContext: o.AdditionalContext,
+	}
+	if o.Line != nil {
+		occ.Line = int32(*o.Line)
+	}
+	if o.Offset != nil {
+		occ.Offset = int32(*o.Offset)
+	}
+	return occ
+}
+
+// organizationalEntityToPerson converts a CDX organizational entity
+// to a protobom person
+func (u *CDX) organizationalEntityToPerson(oe *cdx.OrganizationalEntity) *sbom.Person {
+	p := &sbom.Person{
+		Name:     oe.Name,
+		IsOrg:    true,
+		Contacts: []*sbom.Person{},
+	}
+
+	// TODO(degradation): Only the first organization URL is kept
+	if oe.URL != nil && len(*oe.URL) > 0 {
+		p.Url = (*oe.URL)[0]
+	}
+
+	if oe.Contact != nil {
+		for _, c := range *oe.Contact {
+			p.Contacts = append(p.Contacts, &sbom.Person{
+				Name:  c.Name,
+				Email: c.Email,
+				Phone: c.Phone,
+			})
+		}
+	}
+	return p
+}
+
+// releaseNotesToProtobom reads the release notes of a CDX component
+func (u *CDX) releaseNotesToProtobom(rn *cdx.ReleaseNotes) *sbom.ReleaseNotes {
+	ret := &sbom.ReleaseNotes{
+		Type:        rn.Type,
+		Title:       rn.Title,
+		Description: rn.Description,
+		Notes:       []*sbom.ReleaseNote{},
+	}
+
+	if rn.Timestamp != "" {
+		if t, err := time.Parse(time.RFC3339, rn.Timestamp); err == nil {
+			ret.Timestamp = timestamppb.New(t)
+		} else {
+			logrus.Warnf("unable to parse release notes timestamp %q: %v", rn.Timestamp, err)
+		}
+	}
+
+	if rn.Notes != nil {
+		for _, n := range *rn.Notes {
+			ret.Notes = append(ret.Notes, &sbom.ReleaseNote{
+				Locale:      n.Locale,
+				Text:        n.Text.Content,
+				ContentType: n.Text.ContentType,
+				Encoding:    n.Text.Encoding,
+			})
+		}
+	}
+
+	return ret
+}
+
+// hashesToProtobom reads a list of CDX hashes into a protobom hash map
+func (u *CDX) hashesToProtobom(hashes *[]cdx.Hash) map[int32]string {
+	ret := map[int32]string{}
+	if hashes == nil {
+		return ret
+	}
+	for _, h := range *hashes {
+		algo := sbom.HashAlgorithmFromCDX(h.Algorithm)
+		if algo == sbom.HashAlgorithm_UNKNOWN {
+			// TODO(degradation): Well, not deprecation but invalid SBOM
+			continue
+		}
+
+		if _, ok := ret[int32(algo)]; ok {
+			// TODO(degradation): Data loss from two hashes of the same algorithm
+			continue
+		}
+		ret[int32(algo)] = h.Value
+	}
+	return ret
+}
+
 // unserializeExternalReferences reads a slice of cyclonedx references and returns
 // tjeir protobom equivalents.
 func (u *CDX) unserializeExternalReferences(cdxReferences *[]cdx.ExternalReference) []*sbom.ExternalReference {
@@ -222,6 +1023,14 @@ func (u *CDX) unserializeExternalReferences(cdxReferences *[]cdx.ExternalReferen
 			Hashes:  map[int32]string{},
 			Type:    u.cdxExtRefTypeToProtobomType(extRef.Type),
 		}
+		// Restore the protobom types written as other
+		if extRef.Type == cdx.ERTypeOther && strings.HasPrefix(extRef.Comment, cdxformats.ExtRefTypeCommentPrefix) {
+			typeName, comment, _ := strings.Cut(strings.TrimPrefix(extRef.Comment, cdxformats.ExtRefTypeCommentPrefix), " ")
+			if t, ok := sbom.ExternalReference_ExternalReferenceType_value[strings.ToUpper(typeName)]; ok {
+				nref.Type = sbom.ExternalReference_ExternalReferenceType(t)
+				nref.Comment = comment
+			}
+		}
 		if extRef.Hashes != nil {
 			for _, h := range *extRef.Hashes {
 				algo := int32(u.cdxHashAlgoToProtobomAlgo(h.Algorithm))
@@ -246,16 +1055,12 @@ func (u *CDX) licenseChoicesToLicenseList(lcs *cdx.Licenses) []string {
 		// TODO(license): This should handle licenses without an ID and
 		// create custom licenses or another solution that captures the
 		// full cuistom license text.
-		if lc.Expression == "" && lc.License.ID == "" {
-			continue
-		}
-
-		if lc.Expression != "" {
+		switch {
+		case lc.Expression != "":
 			list = append(list, lc.Expression)
-		} else {
+		case lc.License != nil && lc.License.ID != "":
 			list = append(list, lc.License.ID)
 		}
-		return list
 	}
 
 	return list
@@ -270,31 +1075,18 @@ func (u *CDX) licenseChoicesToLicenseString(lcs *cdx.Licenses) string {
 	if lcs == nil {
 		return ""
 	}
-	s := ""
-	for _, lc := range *lcs {
-		// TODO(license): This should handle licenses without an ID and
-		// create custom licenses or another solution that captures the
-		// full cuistom license text.
-		if lc.Expression == "" && lc.License.ID == "" {
-			continue
-		}
-		if s != "" {
-			s += fmt.Sprintf("(%s) OR ", s)
-		}
+	list := u.licenseChoicesToLicenseList(lcs)
+	if len(list) == 1 {
+		return list[0]
+	}
 
-		newLicense := ""
-		if lc.Expression != "" {
-			newLicense = lc.Expression
-		} else {
-			newLicense = lc.License.ID
-		}
-		if s == "" {
-			s = newLicense
-		} else {
-			s += fmt.Sprintf(" (%s)", newLicense)
+	// Compound expressions are grouped to keep their meaning when joined
+	for i, l := range list {
+		if strings.Contains(l, " ") {
+			list[i] = fmt.Sprintf("(%s)", l)
 		}
 	}
-	return s
+	return strings.Join(list, " OR ")
 }
 
 // phaseToSBOMType converts a CycloneDX lifecycle phase to an SBOM document type
@@ -323,13 +1115,36 @@ func (u *CDX) phaseToSBOMType(ph *cdx.LifecyclePhase) *sbom.DocumentType_SBOMTyp
 	}
 }
 
+// phaseToLifecyclePhase converts a CycloneDX lifecycle phase to its protobom
+// equivalent. Unknown phases are returned as custom lifecycles.
+func (u *CDX) phaseToLifecyclePhase(phase cdx.LifecyclePhase) sbom.Lifecycle_Phase {
+	switch phase {
+	case cdx.LifecyclePhaseDesign:
+		return sbom.Lifecycle_DESIGN
+	case cdx.LifecyclePhasePreBuild:
+		return sbom.Lifecycle_PRE_BUILD
+	case cdx.LifecyclePhaseBuild:
+		return sbom.Lifecycle_BUILD
+	case cdx.LifecyclePhasePostBuild:
+		return sbom.Lifecycle_POST_BUILD
+	case cdx.LifecyclePhaseOperations:
+		return sbom.Lifecycle_OPERATIONS
+	case cdx.LifecyclePhaseDiscovery:
+		return sbom.Lifecycle_DISCOVERY
+	case cdx.LifecyclePhaseDecommission:
+		return sbom.Lifecycle_DECOMMISSION
+	default:
+		return sbom.Lifecycle_CUSTOM
+	}
+}
+
 // componentTypeToPurpose converts the protobom catalog of purposes to the cyclonedx
 // component type.
 func (u *CDX) componentTypeToPurpose(cType cdx.ComponentType) sbom.Purpose {
 	// CycloneDX 1.5 types: "application", "framework", "library", "container",
 	// "platform", "operating-system", "device", "device-driver", "firmware",
 	// "file", "machine-learning-model", "data"
-	switch cType {
+	switch cdxformats.NormalizeComponentType(cType) {
 	case cdx.ComponentTypeApplication:
 		return sbom.Purpose_APPLICATION
 	case cdx.ComponentTypeFramework:
@@ -449,6 +1264,8 @@ func (u *CDX) cdxExtRefTypeToProtobomType(cdxExtRefType cdx.ExternalReferenceTyp
 		return sbom.ExternalReference_QUALITY_METRICS
 	case cdx.ERTypeReleaseNotes:
 		return sbom.ExternalReference_RELEASE_NOTES
+	case cdx.ExternalReferenceType("rfc-9116"):
+		return sbom.ExternalReference_RFC_9116
 	case cdx.ERTypeRiskAssessment:
 		return sbom.ExternalReference_RISK_ASSESSMENT
 	case cdx.ERTypeRuntimeAnalysisReport:
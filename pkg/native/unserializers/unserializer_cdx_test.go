@@ -1,11 +1,25 @@
 package unserializers
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/native/serializers"
 	"github.com/bom-squad/protobom/pkg/sbom"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/unicode"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -29,6 +43,90 @@ func TestCDXPhaseToSBOMType(t *testing.T) {
 	}
 }
 
+func TestCDXPhaseToLifecyclePhase(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	for phase, lphase := range map[cdx.LifecyclePhase]sbom.Lifecycle_Phase{
+		cdx.LifecyclePhaseDesign:       sbom.Lifecycle_DESIGN,
+		cdx.LifecyclePhasePreBuild:     sbom.Lifecycle_PRE_BUILD,
+		cdx.LifecyclePhaseBuild:        sbom.Lifecycle_BUILD,
+		cdx.LifecyclePhasePostBuild:    sbom.Lifecycle_POST_BUILD,
+		cdx.LifecyclePhaseOperations:   sbom.Lifecycle_OPERATIONS,
+		cdx.LifecyclePhaseDiscovery:    sbom.Lifecycle_DISCOVERY,
+		cdx.LifecyclePhaseDecommission: sbom.Lifecycle_DECOMMISSION,
+		cdx.LifecyclePhase(""):         sbom.Lifecycle_CUSTOM,
+		cdx.LifecyclePhase("testing"):  sbom.Lifecycle_CUSTOM,
+	} {
+		require.Equal(t, lphase, cdxu.phaseToLifecyclePhase(phase))
+	}
+}
+
+func TestDocumentTypeRoundTrip(t *testing.T) {
+	for dtype, phase := range map[sbom.DocumentType_SBOMType]cdx.LifecyclePhase{
+		sbom.DocumentType_DESIGN:      cdx.LifecyclePhaseDesign,
+		sbom.DocumentType_SOURCE:      cdx.LifecyclePhasePreBuild,
+		sbom.DocumentType_BUILD:       cdx.LifecyclePhaseBuild,
+		sbom.DocumentType_ANALYZED:    cdx.LifecyclePhasePostBuild,
+		sbom.DocumentType_DEPLOYED:    cdx.LifecyclePhaseOperations,
+		sbom.DocumentType_DISCOVERY:   cdx.LifecyclePhaseDiscovery,
+		sbom.DocumentType_DECOMISSION: cdx.LifecyclePhaseDecommission,
+	} {
+		t.Run(dtype.String(), func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.Metadata.DocumentTypes = []*sbom.DocumentType{{Type: dtype.Enum()}}
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+			lifecycles := *bom.(*cdx.BOM).Metadata.Lifecycles
+			require.Len(t, lifecycles, 1)
+			require.Equal(t, phase, lifecycles[0].Phase)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			require.Len(t, newDoc.Metadata.DocumentTypes, 1)
+			require.Equal(t, dtype, newDoc.Metadata.DocumentTypes[0].GetType())
+		})
+	}
+}
+
+func TestOtherDocumentTypeRoundTrip(t *testing.T) {
+	name, description := "MyPhase", "Custom phase of the pipeline"
+	custom := &sbom.DocumentType{Type: sbom.DocumentType_OTHER.Enum(), Name: &name, Description: &description}
+
+	doc := sbom.NewDocument()
+	doc.Metadata.DocumentTypes = []*sbom.DocumentType{custom}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []cdx.Lifecycle{{Name: name, Description: description}}, *bom.(*cdx.BOM).Metadata.Lifecycles)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, newDoc.Metadata.DocumentTypes, 1)
+	require.True(t, proto.Equal(custom, newDoc.Metadata.DocumentTypes[0]), newDoc.Metadata.DocumentTypes[0].String())
+
+	// Phases unknown to CycloneDX are read as OTHER too
+	newDoc, err = cdxu.Unserialize(strings.NewReader(
+		`{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"metadata":{"lifecycles":[{"phase":"myphase"},{"name":"untyped"}]}}`,
+	), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, newDoc.Metadata.DocumentTypes, 2)
+	require.Equal(t, sbom.DocumentType_OTHER, newDoc.Metadata.DocumentTypes[0].GetType())
+	require.Equal(t, "myphase", newDoc.Metadata.DocumentTypes[0].GetName())
+	require.Nil(t, newDoc.Metadata.DocumentTypes[1].Type)
+}
+
 func TestComponentTypeToPurpose(t *testing.T) {
 	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
 	for compType, purpose := range map[cdx.ComponentType]sbom.Purpose{
@@ -45,6 +143,15 @@ func TestComponentTypeToPurpose(t *testing.T) {
 		cdx.ComponentTypeMachineLearningModel: sbom.Purpose_MACHINE_LEARNING_MODEL,
 		cdx.ComponentTypeData:                 sbom.Purpose_DATA,
 		cdx.ComponentType("crap data"):        sbom.Purpose_UNKNOWN_PURPOSE,
+
+		// Spelling variants
+		cdx.ComponentType("ML-Model"):               sbom.Purpose_MACHINE_LEARNING_MODEL,
+		cdx.ComponentType("machine_learning_model"): sbom.Purpose_MACHINE_LEARNING_MODEL,
+		cdx.ComponentType("operating_system"):       sbom.Purpose_OPERATING_SYSTEM,
+		cdx.ComponentType("OS"):                     sbom.Purpose_OPERATING_SYSTEM,
+		cdx.ComponentType(" Library "):              sbom.Purpose_LIBRARY,
+		cdx.ComponentType("Device Driver"):          sbom.Purpose_DEVICE_DRIVER,
+		cdx.ComponentType("container-image"):        sbom.Purpose_CONTAINER,
 	} {
 		res := cdxu.componentTypeToPurpose(compType)
 		require.Equal(t, purpose, res)
@@ -101,6 +208,7 @@ func TestCdxExtRefTypeToProtobomType(t *testing.T) {
 		cdx.ExternalReferenceType("poam"):          sbom.ExternalReference_POAM,
 		cdx.ERTypeQualityMetrics:                   sbom.ExternalReference_QUALITY_METRICS,
 		cdx.ERTypeReleaseNotes:                     sbom.ExternalReference_RELEASE_NOTES,
+		cdx.ExternalReferenceType("rfc-9116"):      sbom.ExternalReference_RFC_9116,
 		cdx.ERTypeRiskAssessment:                   sbom.ExternalReference_RISK_ASSESSMENT,
 		cdx.ERTypeRuntimeAnalysisReport:            sbom.ExternalReference_RUNTIME_ANALYSIS_REPORT,
 		cdx.ERTypeAdversaryModel:                   sbom.ExternalReference_SECURITY_ADVERSARY_MODEL,
@@ -175,3 +283,1503 @@ func TestDeterministicIds(t *testing.T) {
 		})
 	}
 }
+
+func TestComponentIdentifierProperties(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	cc := 0
+	node, err := cdxu.componentToNode(&cdx.Component{
+		BOMRef: "test",
+		Type:   cdx.ComponentTypeLibrary,
+		Name:   "test",
+		Properties: &[]cdx.Property{
+			{Name: "protobom:identifier:swid", Value: "swid:example.com/test@1.0.0"},
+			{Name: "protobom:identifier:unknown", Value: "ignored"},
+			{Name: "protobom:identifier:42", Value: "future"},
+			{Name: "protobom:identifier:-1", Value: "negative"},
+			{Name: "vendor:property", Value: "ignored"},
+		},
+	}, &cc)
+	require.NoError(t, err)
+	require.Equal(t, map[int32]string{
+		int32(sbom.SoftwareIdentifierType_SWID): "swid:example.com/test@1.0.0",
+		42:                                      "future",
+		-1:                                      "negative",
+	}, node.Identifiers)
+}
+
+func TestAlternatePurlsRoundTrip(t *testing.T) {
+	purls := []string{
+		"pkg:deb/debian/curl@7.88.1",
+		"pkg:deb/ubuntu/curl@7.88.1",
+		"pkg:rpm/fedora/curl@7.88.1",
+	}
+
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id:             "curl",
+		Name:           "curl",
+		Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_PURL): purls[0]},
+		AlternatePurls: purls[1:],
+	})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	node := newDoc.NodeList.GetNodeByID("curl")
+	require.NotNil(t, node)
+	require.Equal(t, purls[0], node.Identifiers[int32(sbom.SoftwareIdentifierType_PURL)])
+	require.Equal(t, purls[1:], node.AlternatePurls)
+	require.Len(t, node.Purls(), 3)
+}
+
+func TestSourceSHA256(t *testing.T) {
+	data, err := os.ReadFile("../../../test/conformance/testdata/cyclonedx/1.5/json/bom-1.5.json")
+	require.NoError(t, err)
+	digest := fmt.Sprintf("%x", sha256.Sum256(data))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	doc, err := cdxu.Unserialize(bytes.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, digest, doc.Metadata.SourceSha256)
+
+	// The digest covers the whole input, not only the decoded document
+	padded := append([]byte("\xef\xbb\xbf"), data...)
+	padded = append(padded, "\n\n"...)
+	doc, err = cdxu.Unserialize(bytes.NewReader(padded), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256(padded)), doc.Metadata.SourceSha256)
+}
+
+func TestDependenciesToEdges(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	nl := &sbom.NodeList{
+		Nodes: []*sbom.Node{{Id: "a"}, {Id: "b"}, {Id: "c"}},
+	}
+	cdxu.dependenciesToEdges(nl, &[]cdx.Dependency{
+		{Ref: "a", Dependencies: &[]string{"b", "c", "unknown"}},
+		{Ref: "b", Dependencies: &[]string{}},
+		{Ref: "unknown", Dependencies: &[]string{"a"}},
+	})
+	require.Equal(t, []*sbom.Edge{
+		{Type: sbom.Edge_dependsOn, From: "a", To: []string{"b", "c"}},
+	}, nl.Edges)
+}
+
+func TestReleaseNotesRoundTrip(t *testing.T) {
+	releaseNotes := &sbom.ReleaseNotes{
+		Type:        "minor",
+		Title:       "Release 1.2.0",
+		Description: "New features",
+		Timestamp:   timestamppb.New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)),
+		Notes: []*sbom.ReleaseNote{
+			{Locale: "en-US", Text: "Added support for widgets", ContentType: "text/plain"},
+			{Locale: "de-DE", Text: "Unterstützung für Widgets hinzugefügt", ContentType: "text/plain"},
+		},
+	}
+
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id:           "app",
+		Name:         "app",
+		Version:      "1.2.0",
+		ReleaseNotes: releaseNotes,
+	})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	node := newDoc.NodeList.GetNodeByID("app")
+	require.NotNil(t, node)
+	require.NotNil(t, node.ReleaseNotes)
+	require.Equal(t, "minor", node.ReleaseNotes.Type)
+	require.Equal(t, "Release 1.2.0", node.ReleaseNotes.Title)
+	require.Equal(t, releaseNotes.Timestamp.AsTime(), node.ReleaseNotes.Timestamp.AsTime())
+	require.Len(t, node.ReleaseNotes.Notes, 2)
+	for i, note := range releaseNotes.Notes {
+		require.Equal(t, note.Locale, node.ReleaseNotes.Notes[i].Locale)
+		require.Equal(t, note.Text, node.ReleaseNotes.Notes[i].Text)
+		require.Equal(t, note.ContentType, node.ReleaseNotes.Notes[i].ContentType)
+	}
+}
+
+func TestSupplierManufacturerRoundTrip(t *testing.T) {
+	supplier := &sbom.Person{
+		Name: "Acme Distribution", IsOrg: true, Url: "https://distribution.example.com",
+		Contacts: []*sbom.Person{{Name: "Support", Email: "support@example.com"}},
+	}
+	manufacturer := &sbom.Person{Name: "Acme Labs", IsOrg: true, Url: "https://labs.example.com"}
+
+	for _, tc := range []struct {
+		version       string
+		manufacturers int
+	}{
+		{"1.6", 1},
+		{"1.5", 0},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{
+				Id:            "app",
+				Name:          "app",
+				Version:       "1.0.0",
+				Suppliers:     []*sbom.Person{supplier},
+				Manufacturers: []*sbom.Person{manufacturer},
+			})
+
+			s := serializers.NewCDX(tc.version, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			cdxu := NewCDX(tc.version, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("app")
+			require.NotNil(t, node)
+			require.Len(t, node.Suppliers, 1)
+			require.Equal(t, supplier.Name, node.Suppliers[0].Name)
+			require.Equal(t, supplier.Url, node.Suppliers[0].Url)
+			require.Len(t, node.Suppliers[0].Contacts, 1)
+			require.Equal(t, "support@example.com", node.Suppliers[0].Contacts[0].Email)
+
+			require.Len(t, node.Manufacturers, tc.manufacturers)
+			if tc.manufacturers > 0 {
+				require.Equal(t, manufacturer.Name, node.Manufacturers[0].Name)
+				require.Equal(t, manufacturer.Url, node.Manufacturers[0].Url)
+			}
+		})
+	}
+}
+
+func TestMetadataSupplierManufacturerRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Suppliers = []*sbom.Person{{Name: "Acme Distribution", IsOrg: true, Url: "https://distribution.example.com"}}
+	doc.Metadata.Manufacturers = []*sbom.Person{{
+		Name: "Acme Labs", IsOrg: true, Url: "https://labs.example.com",
+		Contacts: []*sbom.Person{
+			{Name: "Build Team", Email: "build@example.com"},
+			{Name: "Security Team", Email: "security@example.com", Phone: "+1 555 0100"},
+		},
+	}}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, newDoc.Metadata.Suppliers, 1)
+	require.Equal(t, "Acme Distribution", newDoc.Metadata.Suppliers[0].Name)
+	require.Equal(t, "https://distribution.example.com", newDoc.Metadata.Suppliers[0].Url)
+
+	require.Len(t, newDoc.Metadata.Manufacturers, 1)
+	manufacturer := newDoc.Metadata.Manufacturers[0]
+	require.Equal(t, "Acme Labs", manufacturer.Name)
+	require.Equal(t, "https://labs.example.com", manufacturer.Url)
+	require.Len(t, manufacturer.Contacts, 2)
+	require.Equal(t, "build@example.com", manufacturer.Contacts[0].Email)
+	require.Equal(t, "Security Team", manufacturer.Contacts[1].Name)
+	require.Equal(t, "+1 555 0100", manufacturer.Contacts[1].Phone)
+}
+
+func TestSecurityExtRefsRoundTrip(t *testing.T) {
+	extRefs := []*sbom.ExternalReference{
+		{Url: "https://example.com/.well-known/security.txt", Type: sbom.ExternalReference_RFC_9116},
+		{Url: "mailto:security@example.com", Type: sbom.ExternalReference_SECURITY_CONTACT},
+		{Url: "https://example.com/advisories", Type: sbom.ExternalReference_SECURITY_ADVISORY},
+	}
+
+	for _, tc := range []struct {
+		version  string
+		expected []sbom.ExternalReference_ExternalReferenceType
+	}{
+		{
+			version: "1.6",
+			expected: []sbom.ExternalReference_ExternalReferenceType{
+				sbom.ExternalReference_RFC_9116, sbom.ExternalReference_SECURITY_CONTACT, sbom.ExternalReference_SECURITY_ADVISORY,
+			},
+		},
+		{
+			// Before 1.6 security.txt degrades to a security contact
+			version: "1.5",
+			expected: []sbom.ExternalReference_ExternalReferenceType{
+				sbom.ExternalReference_SECURITY_CONTACT, sbom.ExternalReference_SECURITY_CONTACT, sbom.ExternalReference_SECURITY_ADVISORY,
+			},
+		},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{
+				Id:                 "app",
+				Name:               "app",
+				Version:            "1.0.0",
+				ExternalReferences: extRefs,
+			})
+
+			s := serializers.NewCDX(tc.version, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			cdxu := NewCDX(tc.version, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("app")
+			require.NotNil(t, node)
+			require.Len(t, node.ExternalReferences, len(tc.expected))
+			for i, er := range node.ExternalReferences {
+				require.Equal(t, extRefs[i].Url, er.Url)
+				require.Equal(t, tc.expected[i], er.Type)
+			}
+		})
+	}
+}
+
+func TestBuildExtRefsRoundTrip(t *testing.T) {
+	extRefs := []*sbom.ExternalReference{
+		{Url: "https://ci.example.com/pipelines/app", Type: sbom.ExternalReference_BUILD_SYSTEM},
+		{Url: "https://ci.example.com/pipelines/app/runs/42/provenance.json", Type: sbom.ExternalReference_BUILD_META},
+	}
+
+	for _, version := range []string{"1.4", "1.5", "1.6"} {
+		t.Run(version, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+			doc.NodeList.AddNode(&sbom.Node{
+				Id: "lib", Name: "lib", Version: "2.0.0",
+				PrimaryPurpose:     []sbom.Purpose{sbom.Purpose_LIBRARY},
+				ExternalReferences: extRefs,
+			})
+			doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}})
+
+			s := serializers.NewCDX(version, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+			require.Contains(t, buf.String(), `"build-system"`)
+			require.Contains(t, buf.String(), `"build-meta"`)
+
+			cdxu := NewCDX(version, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("lib")
+			require.NotNil(t, node)
+			require.Len(t, node.ExternalReferences, len(extRefs))
+			for i, er := range node.ExternalReferences {
+				require.True(t, proto.Equal(extRefs[i], er), er.String())
+			}
+		})
+	}
+}
+
+func TestUngroupComponents(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "lib1", Name: "lib1", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file1", Name: "file1", Type: sbom.Node_FILE})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "file1"}})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{GroupComponentsByType: true}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	// The synthetic groups are not read as nodes
+	require.Len(t, newDoc.NodeList.Nodes, 3)
+	edge := newDoc.NodeList.GetEdgeByType("app", sbom.Edge_contains)
+	require.NotNil(t, edge)
+	require.Equal(t, []string{"lib1", "file1"}, edge.To)
+}
+
+// writeLargeCDX writes a CycloneDX JSON document with n components to w. The
+// metadata is written after the components to exercise the stream ordering.
+func writeLargeCDX(w io.Writer, n int) error {
+	if _, err := fmt.Fprint(w, `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"components":[`); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		sep := ","
+		if i == 0 {
+			sep = ""
+		}
+		if _, err := fmt.Fprintf(w,
+			`%s{"bom-ref":"comp-%d","type":"library","name":"comp-%d","version":"1.0.%d","purl":"pkg:generic/comp-%d@1.0.%d"}`,
+			sep, i, i, i, i, i,
+		); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(w, `],"metadata":{"component":{"bom-ref":"root","type":"application","name":"root"}},`+
+		`"dependencies":[{"ref":"comp-0","dependsOn":["comp-1"]}]}`)
+	return err
+}
+
+func TestUnserializeStreamLarge(t *testing.T) {
+	const n = 10000
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeLargeCDX(pw, n))
+	}()
+
+	doc, err := NewCDX("1.5", "json").Unserialize(pr, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, doc.NodeList.Nodes, n+1)
+	require.Equal(t, []string{"root"}, doc.NodeList.RootElements)
+	require.Equal(t, "1", doc.Metadata.Version)
+
+	last := doc.NodeList.GetNodeByID(fmt.Sprintf("comp-%d", n-1))
+	require.NotNil(t, last)
+	require.Equal(t, fmt.Sprintf("1.0.%d", n-1), last.Version)
+
+	contained := doc.NodeList.NodeGraph("root").Nodes
+	require.Len(t, contained, n+1)
+	require.Equal(t, []string{"comp-1"}, doc.NodeList.GetEdgeByType("comp-0", sbom.Edge_dependsOn).To)
+}
+
+func TestUnserializeStreamNullComponents(t *testing.T) {
+	doc, err := NewCDX("1.5", "json").Unserialize(
+		strings.NewReader(`{"bomFormat":"CycloneDX","specVersion":"1.5","version":2,"components":null}`),
+		&native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, doc.NodeList.Nodes)
+	require.Equal(t, "2", doc.Metadata.Version)
+
+	_, err = NewCDX("1.5", "json").Unserialize(
+		strings.NewReader(`{"components":{}}`), &native.UnserializeOptions{}, nil,
+	)
+	require.Error(t, err)
+}
+
+func TestUnserializeWithVulnerabilities(t *testing.T) {
+	// Vulnerabilities are not modeled, but they must not keep the rest of
+	// the document from being read
+	data := `{
+		"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+		"metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+		"components": [{"bom-ref": "lib", "type": "library", "name": "lib", "version": "1.2.0"}],
+		"dependencies": [{"ref": "app", "dependsOn": ["lib"]}],
+		"vulnerabilities": [{
+			"bom-ref": "vuln-1",
+			"id": "CVE-2024-0001",
+			"analysis": {"state": "not_affected", "justification": "code_not_reachable"},
+			"affects": [{"ref": "lib", "versions": [{"range": "vers:semver/>=1.0.0|<2.0.0", "status": "affected"}]}]
+		}]
+	}`
+
+	doc, err := NewCDX("1.5", "json").Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, doc.NodeList.Nodes, 2)
+	require.Equal(t, []string{"app"}, doc.NodeList.RootElements)
+	require.NotNil(t, doc.NodeList.GetNodeByID("lib"))
+	edge := doc.NodeList.GetEdgeByType("app", sbom.Edge_dependsOn)
+	require.NotNil(t, edge)
+	require.Equal(t, []string{"lib"}, edge.To)
+}
+
+func TestUnserializeWithoutComponents(t *testing.T) {
+	for name, tc := range map[string]struct {
+		data  string
+		nodes int
+		roots []string
+	}{
+		"absent": {
+			data: `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1}`,
+		},
+		"null": {
+			data: `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"components":null,"dependencies":null}`,
+		},
+		"metadata component only": {
+			data: `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,` +
+				`"metadata":{"component":{"bom-ref":"app","type":"application","name":"app","components":null}},` +
+				`"components":null,"dependencies":[{"ref":"app"}]}`,
+			nodes: 1,
+			roots: []string{"app"},
+		},
+		"empty dependencies": {
+			data: `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,` +
+				`"metadata":{"component":{"bom-ref":"app","type":"application","name":"app"}},` +
+				`"dependencies":[{"ref":"app","dependsOn":[]}]}`,
+			nodes: 1,
+			roots: []string{"app"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := NewCDX("1.5", "json").Unserialize(strings.NewReader(tc.data), &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			require.NotNil(t, doc.NodeList)
+			require.Len(t, doc.NodeList.Nodes, tc.nodes)
+			require.Equal(t, tc.roots, doc.NodeList.RootElements)
+			require.Empty(t, doc.NodeList.Edges)
+
+			// The parsed document is complete enough to be serialized again
+			_, err = serializers.NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func BenchmarkUnserializeLargeCDX(b *testing.B) {
+	var buf bytes.Buffer
+	require.NoError(b, writeLargeCDX(&buf, 10000))
+	data := buf.Bytes()
+
+	cdxu := NewCDX("1.5", "json")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cdxu.Unserialize(bytes.NewReader(data), &native.UnserializeOptions{}, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMetadataAuthorsRoundTrip(t *testing.T) {
+	data := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"metadata":{"authors":[` +
+		`{"name":"Jane Doe","email":"jane@example.com","phone":"555-0100"},` +
+		`{"name":"John Doe","email":"john@example.com"}]}}`
+	expected := []*sbom.Person{
+		{Name: "Jane Doe", Email: "jane@example.com", Phone: "555-0100"},
+		{Name: "John Doe", Email: "john@example.com"},
+	}
+
+	doc, err := NewCDX("1.5", "json").Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, doc.Metadata.Authors, 2)
+	for i := range expected {
+		require.True(t, proto.Equal(expected[i], doc.Metadata.Authors[i]), doc.Metadata.Authors[i].String())
+	}
+
+	// Written back, the authors keep their contact data
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	bom, err := serializers.NewCDX("1.5", "json").Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []cdx.OrganizationalContact{
+		{Name: "Jane Doe", Email: "jane@example.com", Phone: "555-0100"},
+		{Name: "John Doe", Email: "john@example.com"},
+	}, *bom.(*cdx.BOM).Metadata.Authors)
+}
+
+func TestComponentAuthors(t *testing.T) {
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	for _, tc := range []struct {
+		name     string
+		sut      *cdx.Component
+		expected []*sbom.Person
+	}{
+		{
+			name:     "deprecated author string",
+			sut:      &cdx.Component{BOMRef: "lib", Author: "Jane Doe"},
+			expected: []*sbom.Person{{Name: "Jane Doe"}},
+		},
+		{
+			name: "authors list",
+			sut: &cdx.Component{
+				BOMRef: "lib",
+				Authors: &[]cdx.OrganizationalContact{
+					{Name: "Jane Doe", Email: "jane@example.com"},
+					{Name: "John Doe", Phone: "555-0100"},
+				},
+			},
+			expected: []*sbom.Person{
+				{Name: "Jane Doe", Email: "jane@example.com"},
+				{Name: "John Doe", Phone: "555-0100"},
+			},
+		},
+		{
+			name: "authors list takes precedence",
+			sut: &cdx.Component{
+				BOMRef:  "lib",
+				Author:  "Someone Else",
+				Authors: &[]cdx.OrganizationalContact{{Name: "Jane Doe"}},
+			},
+			expected: []*sbom.Person{{Name: "Jane Doe"}},
+		},
+		{
+			name:     "no authors",
+			sut:      &cdx.Component{BOMRef: "lib"},
+			expected: []*sbom.Person{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := 0
+			node, err := cdxu.componentToNode(tc.sut, &cc)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, node.Originators)
+		})
+	}
+}
+
+func TestUnserializeDedupByPURL(t *testing.T) {
+	input := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,
+		"metadata":{"component":{"bom-ref":"root","type":"application","name":"root"}},
+		"components":[
+			{"bom-ref":"lib-1","type":"library","name":"lib","version":"1.0.0","purl":"pkg:npm/lib@1.0.0",
+			 "hashes":[{"alg":"SHA-1","content":"a94a8fe5ccb19ba61c4c0873d391e987982fbbd3"}]},
+			{"bom-ref":"lib-2","type":"library","name":"lib","version":"1.0.0","purl":"pkg:npm/lib@1.0.0",
+			 "licenses":[{"license":{"id":"MIT"}}]},
+			{"bom-ref":"other","type":"library","name":"other","version":"2.0.0","purl":"pkg:npm/other@2.0.0"}
+		],
+		"dependencies":[{"ref":"lib-2","dependsOn":["other"]}]}`
+
+	for _, tc := range []struct {
+		strategy native.DedupStrategy
+		nodes    int
+	}{
+		{native.DedupNone, 4},
+		{native.DedupByPURL, 3},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			doc, err := NewCDX("1.5", "json").Unserialize(
+				strings.NewReader(input), &native.UnserializeOptions{DedupStrategy: tc.strategy}, nil,
+			)
+			require.NoError(t, err)
+			require.Len(t, doc.NodeList.Nodes, tc.nodes)
+			if tc.strategy == native.DedupNone {
+				return
+			}
+
+			require.Nil(t, doc.NodeList.GetNodeByID("lib-2"))
+			lib := doc.NodeList.GetNodeByID("lib-1")
+			require.NotNil(t, lib)
+			require.Len(t, lib.Hashes, 1)
+			require.Equal(t, []string{"MIT"}, lib.Licenses)
+			require.Equal(t, []string{"other"}, doc.NodeList.GetEdgeByType("lib-1", sbom.Edge_dependsOn).To)
+			require.Equal(t, []string{"lib-1", "other"}, doc.NodeList.GetEdgeByType("root", sbom.Edge_contains).To)
+		})
+	}
+
+	_, err := NewCDX("1.5", "json").Unserialize(
+		strings.NewReader(input), &native.UnserializeOptions{DedupStrategy: "random"}, nil,
+	)
+	require.Error(t, err)
+}
+
+func TestUnserializeDedupByContentHash(t *testing.T) {
+	// "both" has the SHA-1 of "sha1" and the SHA-256 of "sha256"
+	input := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,
+		"metadata":{"component":{"bom-ref":"root","type":"application","name":"root"}},
+		"components":[
+			{"bom-ref":"sha1","type":"library","name":"lib","version":"1.0.0",
+			 "hashes":[{"alg":"SHA-1","content":"a94a8fe5ccb19ba61c4c0873d391e987982fbbd3"}]},
+			{"bom-ref":"sha256","type":"library","name":"lib","version":"1.0.0",
+			 "hashes":[{"alg":"SHA-256","content":"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"}]},
+			{"bom-ref":"both","type":"library","name":"lib","version":"1.0.0",
+			 "hashes":[
+				{"alg":"SHA-256","content":"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+				{"alg":"SHA-1","content":"a94a8fe5ccb19ba61c4c0873d391e987982fbbd3"}
+			 ]}
+		]}`
+
+	// The surviving node must not depend on the map iteration order
+	for i := 0; i < 20; i++ {
+		doc, err := NewCDX("1.5", "json").Unserialize(
+			strings.NewReader(input), &native.UnserializeOptions{DedupStrategy: native.DedupByContentHash}, nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, doc.NodeList.Nodes, 3)
+		require.Nil(t, doc.NodeList.GetNodeByID("both"))
+		require.Len(t, doc.NodeList.GetNodeByID("sha1").Hashes, 2)
+		require.Len(t, doc.NodeList.GetNodeByID("sha256").Hashes, 1)
+	}
+}
+
+func TestUnserializeByteOrderMark(t *testing.T) {
+	data, err := os.ReadFile("../../../test/conformance/testdata/cyclonedx/1.5/json/bom-1.5.json")
+	require.NoError(t, err)
+
+	utf16 := func(order unicode.Endianness) []byte {
+		encoded, err := unicode.UTF16(order, unicode.UseBOM).NewEncoder().Bytes(data)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	expected, err := NewCDX("1.5", "json").Unserialize(bytes.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	for name, input := range map[string][]byte{
+		"utf-8":    append([]byte{0xEF, 0xBB, 0xBF}, data...),
+		"utf-16be": utf16(unicode.BigEndian),
+		"utf-16le": utf16(unicode.LittleEndian),
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := NewCDX("1.5", "json").Unserialize(bytes.NewReader(input), &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			// The digest is the one of the input as read
+			require.Equal(t, fmt.Sprintf("%x", sha256.Sum256(input)), doc.Metadata.SourceSha256)
+			doc.Metadata.SourceSha256 = expected.Metadata.SourceSha256
+			require.True(t, proto.Equal(expected, doc))
+		})
+	}
+}
+
+func TestNodePropertiesRoundTrip(t *testing.T) {
+	properties := []*sbom.Property{
+		{Name: "syft:package:type", Value: "go-module"},
+		{Name: "syft:location:0:path", Value: "/go.mod"},
+		{Name: "syft:location:0:path", Value: "/vendor/modules.txt"},
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_GITOID): "gitoid:blob:sha1:261eeb9e9f8b2b4b0d119366dda99c6fd7d35c64",
+		},
+		// The protobom namespace is reserved, this one is not written
+		Properties: append(properties, &sbom.Property{Name: "protobom:group", Value: "library"}),
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"lib"}})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+	require.Contains(t, buf.String(), `"syft:package:type"`)
+	require.Contains(t, buf.String(), `"go-module"`)
+	require.NotContains(t, buf.String(), "protobom:group")
+
+	cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+
+	node := newDoc.NodeList.GetNodeByID("lib")
+	require.NotNil(t, node)
+	require.Len(t, node.Properties, len(properties))
+	for i := range properties {
+		require.True(t, proto.Equal(properties[i], node.Properties[i]), node.Properties[i].String())
+	}
+	require.Contains(t, node.Identifiers, int32(sbom.SoftwareIdentifierType_GITOID))
+}
+
+func TestBuildPropertiesRoundTrip(t *testing.T) {
+	properties := []*sbom.Property{
+		{Name: cdxformats.PropertyBuildPrefix + "compiler", Value: "gcc 13.2.0"},
+		{Name: cdxformats.PropertyBuildPrefix + "flags", Value: "-O2 -fstack-protector-strong -D_FORTIFY_SOURCE=2"},
+		{Name: cdxformats.PropertyBuildPrefix + "hardening", Value: "pie,relro,now"},
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "bin", Name: "bin", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_EXECUTABLE},
+		Properties:     properties,
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"bin"}})
+
+	// Build metadata is node data, it is kept when the protobom
+	// properties are disabled
+	for _, disable := range []bool{false, true} {
+		t.Run(fmt.Sprintf("disable properties %v", disable), func(t *testing.T) {
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{DisableProperties: disable}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+			require.Contains(t, buf.String(), `"protobom:build:compiler"`)
+
+			newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+				&buf, &native.UnserializeOptions{}, nil,
+			)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("bin")
+			require.NotNil(t, node)
+			require.Len(t, node.Properties, len(properties))
+			for i := range properties {
+				require.True(t, proto.Equal(properties[i], node.Properties[i]), node.Properties[i].String())
+			}
+		})
+	}
+}
+
+func TestDocumentVersionRoundTrip(t *testing.T) {
+	input := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":3,
+		"serialNumber":"urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+		"metadata":{"component":{"bom-ref":"root","type":"application","name":"root"}}}`
+
+	doc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		strings.NewReader(input), &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "3", doc.Metadata.Version)
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, bom.(*cdx.BOM).Version)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+	require.Contains(t, buf.String(), `"version": 3`)
+}
+
+func TestDocumentExternalReferencesRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.ExternalReferences = []*sbom.ExternalReference{
+		{Url: "https://github.com/example/app", Type: sbom.ExternalReference_VCS, Comment: "sources"},
+	}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	got, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		&buf, &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, got.Metadata.ExternalReferences, 1)
+	require.True(t, proto.Equal(doc.Metadata.ExternalReferences[0], got.Metadata.ExternalReferences[0]))
+}
+
+func TestNonNumericDocumentVersionRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Version = "1.0.0-rc1"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{Compact: true}, nil))
+	require.Contains(t, buf.String(), `"version":1`)
+
+	got, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		&buf, &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0-rc1", got.Metadata.Version)
+}
+
+func TestExtraSuppliersRoundTrip(t *testing.T) {
+	suppliers := []*sbom.Person{
+		{Name: "Acme Inc", IsOrg: true, Url: "https://acme.example.com/"},
+		{
+			Name: "Example Corp", IsOrg: true,
+			Contacts: []*sbom.Person{{Name: "Jane Doe", Email: "jane@example.com"}},
+		},
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Suppliers: suppliers})
+
+	for _, tc := range []struct {
+		strategy native.ExtraSuppliers
+		expected []*sbom.Person
+	}{
+		{native.ExtraSuppliersDrop, suppliers[:1]},
+		{native.ExtraSuppliersProperties, suppliers},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{ExtraSuppliers: tc.strategy}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("root")
+			require.NotNil(t, node)
+			require.Len(t, node.Suppliers, len(tc.expected))
+			for i := range tc.expected {
+				require.True(t, proto.Equal(tc.expected[i], node.Suppliers[i]), node.Suppliers[i].String())
+			}
+			require.Empty(t, node.Properties)
+		})
+	}
+}
+
+func TestCPE22PropertyRoundTrip(t *testing.T) {
+	identifiers := map[int32]string{
+		int32(sbom.SoftwareIdentifierType_CPE22): "cpe:/a:haxx:curl:8.4.0",
+		int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:haxx:curl:8.4.0:*:*:*:*:*:*:*",
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "curl", Name: "curl", Version: "8.4.0", Identifiers: identifiers})
+
+	for _, tc := range []struct {
+		name     string
+		enabled  bool
+		expected []sbom.SoftwareIdentifierType
+	}{
+		{"disabled", false, []sbom.SoftwareIdentifierType{sbom.SoftwareIdentifierType_CPE23}},
+		{"enabled", true, []sbom.SoftwareIdentifierType{sbom.SoftwareIdentifierType_CPE22, sbom.SoftwareIdentifierType_CPE23}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{CPE22Property: tc.enabled}, nil)
+			require.NoError(t, err)
+			require.Equal(t, identifiers[int32(sbom.SoftwareIdentifierType_CPE23)], bom.(*cdx.BOM).Metadata.Component.CPE)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			cdxu := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			newDoc, err := cdxu.Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+
+			node := newDoc.NodeList.GetNodeByID("curl")
+			require.NotNil(t, node)
+			require.Len(t, node.Identifiers, len(tc.expected))
+			for _, idType := range tc.expected {
+				require.Equal(t, identifiers[int32(idType)], node.Identifiers[int32(idType)])
+			}
+		})
+	}
+}
+
+func TestToolHashesAndExtRefsRoundTrip(t *testing.T) {
+	tool := &sbom.Tool{
+		Name: "scanner", Version: "1.2.0", Vendor: "Acme Inc",
+		Hashes: map[int32]string{
+			int32(sbom.HashAlgorithm_SHA256): "4f232eeb99e1663d07f0af1af6ea262bf594934b694228e71fd8f159f9a19f32",
+		},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://scanner.example.com/", Type: sbom.ExternalReference_WEBSITE, Hashes: map[int32]string{}},
+		},
+	}
+	doc := sbom.NewDocument()
+	doc.Metadata.Tools = []*sbom.Tool{tool}
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root"})
+
+	// Tools are written in the legacy array up to 1.4 and as components
+	// since 1.5, both keep the hashes and external references
+	for _, version := range []string{"1.4", "1.5", "1.6"} {
+		for _, encoding := range []string{"json", "xml"} {
+			t.Run(version+"/"+encoding, func(t *testing.T) {
+				s := serializers.NewCDX(version, encoding)
+				bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+				require.Contains(t, buf.String(), tool.Hashes[int32(sbom.HashAlgorithm_SHA256)])
+
+				newDoc, err := NewCDX(version, encoding).Unserialize(&buf, &native.UnserializeOptions{}, nil)
+				require.NoError(t, err)
+				require.Len(t, newDoc.Metadata.Tools, 1)
+				require.True(t, proto.Equal(tool, newDoc.Metadata.Tools[0]), newDoc.Metadata.Tools[0].String())
+			})
+		}
+	}
+}
+
+func TestExtRefTypeCommentRoundTrip(t *testing.T) {
+	refs := []*sbom.ExternalReference{
+		{Url: "https://github.com/sponsors/example", Type: sbom.ExternalReference_FUNDING, Hashes: map[int32]string{}},
+		{
+			Url: "https://registry.npmjs.org/lib/-/lib-1.0.0.tgz", Type: sbom.ExternalReference_NPM,
+			Comment: "tarball of the release", Hashes: map[int32]string{},
+		},
+		{Url: "https://example.com/other", Type: sbom.ExternalReference_OTHER, Comment: "something else", Hashes: map[int32]string{}},
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "lib", Name: "lib", ExternalReferences: refs})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	cdxRefs := *bom.(*cdx.BOM).Metadata.Component.ExternalReferences
+	require.Equal(t, cdx.ERTypeOther, cdxRefs[0].Type)
+	require.Equal(t, "protobom:type:funding", cdxRefs[0].Comment)
+	require.Equal(t, "protobom:type:npm tarball of the release", cdxRefs[1].Comment)
+	require.Equal(t, "something else", cdxRefs[2].Comment)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+	newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	node := newDoc.NodeList.GetNodeByID("lib")
+	require.NotNil(t, node)
+	require.Len(t, node.ExternalReferences, len(refs))
+	for i := range refs {
+		require.True(t, proto.Equal(refs[i], node.ExternalReferences[i]), node.ExternalReferences[i].String())
+	}
+}
+
+func TestExtRefCommentHashesRoundTrip(t *testing.T) {
+	digest := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	ref := &sbom.ExternalReference{
+		Url:     "https://example.com/releases/lib-1.0.0.tar.gz",
+		Type:    sbom.ExternalReference_DOWNLOAD,
+		Comment: "signed release tarball",
+		Hashes:  map[int32]string{int32(sbom.HashAlgorithm_SHA256): digest},
+	}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "lib", Name: "lib", ExternalReferences: []*sbom.ExternalReference{ref}})
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{Compact: true}, nil))
+	require.Contains(t, buf.String(), `"comment":"signed release tarball","hashes":[{"alg":"SHA-256","content":"`+digest+`"}]`)
+
+	newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	node := newDoc.NodeList.GetNodeByID("lib")
+	require.NotNil(t, node)
+	require.Len(t, node.ExternalReferences, 1)
+	require.True(t, proto.Equal(ref, node.ExternalReferences[0]), node.ExternalReferences[0].String())
+}
+
+func TestEdgeTypesRoundTrip(t *testing.T) {
+	for value, name := range sbom.Edge_Type_name {
+		edgeType := sbom.Edge_Type(value)
+		if edgeType == sbom.Edge_UNKNOWN {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+			doc.NodeList.AddNode(&sbom.Node{Id: "lib", Name: "lib"})
+			doc.NodeList.AddNode(&sbom.Node{Id: "dep", Name: "dep"})
+			doc.NodeList.AddEdge(&sbom.Edge{Type: edgeType, From: "lib", To: []string{"dep"}})
+			if edgeType == sbom.Edge_contains {
+				doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+			} else {
+				doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib", "dep"}})
+			}
+
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+				&buf, &native.UnserializeOptions{}, nil,
+			)
+			require.NoError(t, err)
+			edge := newDoc.NodeList.GetEdgeByType("lib", edgeType)
+			require.NotNil(t, edge)
+			require.Equal(t, []string{"dep"}, edge.To)
+			require.Empty(t, newDoc.NodeList.GetNodeByID("lib").Properties)
+		})
+	}
+}
+
+func TestSerializeStreamRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Version: "1.0.0"})
+	for _, id := range []string{"lib1", "lib2", "file1"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id, Version: "1.0.0"})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+
+	var buf bytes.Buffer
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	require.NoError(t, s.SerializeStream(context.Background(), doc, &buf, &native.SerializeOptions{}))
+
+	newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		&buf, &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"app"}, newDoc.NodeList.RootElements)
+	require.Len(t, newDoc.NodeList.Nodes, 4)
+	require.Equal(t, []string{"lib1", "lib2"}, newDoc.NodeList.GetEdgeByType("app", sbom.Edge_contains).To)
+	require.Equal(t, []string{"file1"}, newDoc.NodeList.GetEdgeByType("lib2", sbom.Edge_contains).To)
+	require.Equal(t, []string{"lib2"}, newDoc.NodeList.GetEdgeByType("lib1", sbom.Edge_dependsOn).To)
+}
+
+func TestSerializeToBytesRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "2.0.0", Licenses: []string{"MIT"}, LicenseConcluded: "MIT",
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "app", To: []string{"lib"}})
+
+	data, err := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).SerializeToBytes(
+		context.Background(), doc, &native.SerializeOptions{},
+	)
+	require.NoError(t, err)
+
+	newDoc, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		bytes.NewReader(data), &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, doc.Metadata.Id, newDoc.Metadata.Id)
+	require.True(t, doc.NodeList.Equal(newDoc.NodeList))
+
+	_, err = serializers.NewCDX("0.9", cdxUnserializerTestEncoding).SerializeToBytes(
+		context.Background(), doc, &native.SerializeOptions{},
+	)
+	require.Error(t, err)
+}
+
+func TestNativeIdentifiersRoundTrip(t *testing.T) {
+	gitoid := "gitoid:blob:sha1:261eeb9e9f8b2b4b0d119366dda99c6fd7d35c64"
+	swhid := "swh:1:cnt:94a9ed024d3859793618152ea559a168bbcbb5e2"
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", Version: "1.0.0",
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_GITOID): gitoid,
+			int32(sbom.SoftwareIdentifierType_SWHID):  swhid,
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"lib"}})
+
+	for _, tc := range []struct {
+		version string
+		native  bool
+	}{
+		{"1.5", false},
+		{"1.6", true},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			s := serializers.NewCDX(tc.version, "json")
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{Compact: true}, nil))
+			if tc.native {
+				require.Contains(t, buf.String(), `"omniborId":["`+gitoid+`"]`)
+				require.Contains(t, buf.String(), `"swhid":["`+swhid+`"]`)
+				require.NotContains(t, buf.String(), cdxformats.PropertyIdentifierPrefix)
+			} else {
+				require.NotContains(t, buf.String(), "omniborId")
+				require.Contains(t, buf.String(), cdxformats.PropertyIdentifierPrefix+"gitoid")
+			}
+
+			newDoc, err := NewCDX(tc.version, "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			node := newDoc.NodeList.GetNodeByID("lib")
+			require.NotNil(t, node)
+			require.Equal(t, gitoid, node.Identifiers[int32(sbom.SoftwareIdentifierType_GITOID)])
+			require.Equal(t, swhid, node.Identifiers[int32(sbom.SoftwareIdentifierType_SWHID)])
+		})
+	}
+}
+
+func TestOccurrencesRoundTrip(t *testing.T) {
+	occurrence := &sbom.Occurrence{Location: "src/vendor/zlib/inflate.c", Line: 42, Offset: 5, Symbol: "inflate"}
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Version: "1.0.0"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "zlib", Name: "zlib", Version: "1.3.1",
+		Occurrences: []*sbom.Occurrence{occurrence, {Location: "src/vendor/zlib/zlib.h"}},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"zlib"}})
+
+	for _, tc := range []struct {
+		version string
+		// expected is the first occurrence after the round trip
+		expected *sbom.Occurrence
+	}{
+		{"1.4", nil},
+		{"1.5", &sbom.Occurrence{Location: occurrence.Location}},
+		{"1.6", occurrence},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			s := serializers.NewCDX(tc.version, "json")
+			bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			newDoc, err := NewCDX(tc.version, "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			node := newDoc.NodeList.GetNodeByID("zlib")
+			require.NotNil(t, node)
+			if tc.expected == nil {
+				require.Empty(t, node.Occurrences)
+				return
+			}
+			require.Len(t, node.Occurrences, 2)
+			require.Equal(t, tc.expected.Location, node.Occurrences[0].Location)
+			require.Equal(t, tc.expected.Line, node.Occurrences[0].Line)
+			require.Equal(t, tc.expected.Offset, node.Occurrences[0].Offset)
+			require.Equal(t, tc.expected.Symbol, node.Occurrences[0].Symbol)
+			require.Equal(t, "src/vendor/zlib/zlib.h", node.Occurrences[1].Location)
+		})
+	}
+}
+
+func TestValidUntilDateRoundTrip(t *testing.T) {
+	validUntil := time.Date(2027, time.March, 31, 23, 59, 59, 0, time.UTC)
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "cert", Name: "cert", ValidUntilDate: timestamppb.New(validUntil),
+		PrimaryPurpose: []sbom.Purpose{sbom.Purpose_DATA},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"cert"}})
+
+	for _, tc := range []struct {
+		name     string
+		opts     *native.SerializeOptions
+		expected *timestamppb.Timestamp
+	}{
+		{"property", &native.SerializeOptions{}, timestamppb.New(validUntil)},
+		{"properties disabled", &native.SerializeOptions{DisableProperties: true}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+			bom, err := s.Serialize(doc, tc.opts, nil)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+
+			got, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+				&buf, &native.UnserializeOptions{}, nil,
+			)
+			require.NoError(t, err)
+			node := got.NodeList.GetNodeByID("cert")
+			require.NotNil(t, node)
+			require.True(t, proto.Equal(tc.expected, node.ValidUntilDate))
+			require.Empty(t, node.Properties)
+		})
+	}
+}
+
+func TestCustomPropertyPrefixRoundTrip(t *testing.T) {
+	validUntil := time.Date(2027, time.March, 31, 23, 59, 59, 0, time.UTC)
+	doc := sbom.NewDocument()
+	doc.Metadata.Version = "1.0.0-rc1"
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib", Name: "lib", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+		Identifiers:    map[int32]string{int32(sbom.SoftwareIdentifierType_SWHID): "swh:1:cnt:94a9ed024d3859793618152ea559a168bbcbb5e2"},
+		ValidUntilDate: timestamppb.New(validUntil),
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://example.com/formulation.json", Type: sbom.ExternalReference_FORMULATION},
+		},
+		Properties: []*sbom.Property{
+			{Name: cdxformats.PropertyBuildPrefix + "compiler", Value: "gcc"},
+			{Name: "acme:team", Value: "platform"},
+		},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "cc", Name: "cc", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib", "cc"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_buildTool, From: "lib", To: []string{"cc"}})
+
+	s := serializers.NewCDX("1.4", "json")
+	for name, serialize := range map[string]func(*bytes.Buffer, *native.SerializeOptions) error{
+		"buffered": func(buf *bytes.Buffer, opts *native.SerializeOptions) error {
+			bom, err := s.Serialize(doc, opts, nil)
+			if err != nil {
+				return err
+			}
+			return s.Render(bom, buf, &native.RenderOptions{}, nil)
+		},
+		"stream": func(buf *bytes.Buffer, opts *native.SerializeOptions) error {
+			return s.SerializeStream(context.Background(), doc, buf, opts)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, serialize(&buf, &native.SerializeOptions{PropertyPrefix: "acme:"}))
+			data := buf.String()
+			require.NotContains(t, data, cdxformats.PropertyPrefix)
+			for _, name := range []string{
+				"acme:document:version", "acme:identifier:swhid", "acme:valid-until",
+				"acme:edge:buildTool", "acme:build:compiler", "acme:type:formulation",
+			} {
+				require.Contains(t, data, name)
+			}
+			// The node property in the custom namespace is reserved
+			require.NotContains(t, data, "acme:team")
+
+			got, err := NewCDX("1.4", "json").Unserialize(
+				strings.NewReader(data), &native.UnserializeOptions{PropertyPrefix: "acme:"}, nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, doc.Metadata.Version, got.Metadata.Version)
+			lib := got.NodeList.GetNodeByID("lib")
+			require.NotNil(t, lib)
+			require.Equal(t, doc.NodeList.GetNodeByID("lib").Identifiers, lib.Identifiers)
+			require.True(t, proto.Equal(timestamppb.New(validUntil), lib.ValidUntilDate))
+			require.Len(t, lib.ExternalReferences, 1)
+			require.Equal(t, sbom.ExternalReference_FORMULATION, lib.ExternalReferences[0].Type)
+			require.Len(t, lib.Properties, 1)
+			require.Equal(t, cdxformats.PropertyBuildPrefix+"compiler", lib.Properties[0].Name)
+			edge := got.NodeList.GetEdgeByType("lib", sbom.Edge_buildTool)
+			require.NotNil(t, edge)
+			require.Equal(t, []string{"cc"}, edge.To)
+
+			// Without the prefix, the properties are read as plain node data
+			got, err = NewCDX("1.4", "json").Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			lib = got.NodeList.GetNodeByID("lib")
+			require.NotNil(t, lib)
+			require.Nil(t, lib.ValidUntilDate)
+			require.Nil(t, got.NodeList.GetEdgeByType("lib", sbom.Edge_buildTool))
+		})
+	}
+}
+
+func TestPedigreePatchesRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{Id: "openssl", Name: "openssl", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY}})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "fix", Name: "CVE-2024-1234.patch", PrimaryPurpose: []sbom.Purpose{sbom.Purpose_PATCH},
+		ExternalReferences: []*sbom.ExternalReference{
+			{Url: "https://example.com/CVE-2024-1234.patch", Type: sbom.ExternalReference_DOWNLOAD},
+			{Url: "https://nvd.nist.gov/vuln/detail/CVE-2024-1234", Type: sbom.ExternalReference_SECURITY_ADVISORY, Comment: "CVE-2024-1234"},
+		},
+	})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"openssl", "fix"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_patch, From: "fix", To: []string{"openssl"}})
+
+	expected := []cdx.Patch{{
+		Type: cdx.PatchTypeUnofficial,
+		Diff: &cdx.Diff{URL: "https://example.com/CVE-2024-1234.patch"},
+		Resolves: &[]cdx.Issue{{
+			Type: cdx.IssueTypeSecurity, ID: "CVE-2024-1234",
+			References: &[]string{"https://nvd.nist.gov/vuln/detail/CVE-2024-1234"},
+		}},
+	}}
+
+	s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+	patches := func(doc *sbom.Document) []cdx.Patch {
+		bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+		require.NoError(t, err)
+		for _, c := range *bom.(*cdx.BOM).Metadata.Component.Components {
+			if c.BOMRef == "openssl" {
+				require.NotNil(t, c.Pedigree)
+				return *c.Pedigree.Patches
+			}
+		}
+		t.Fatal("patched component not found")
+		return nil
+	}
+	require.Equal(t, expected, patches(doc))
+
+	// The streaming serializer writes the same patches
+	var streamed bytes.Buffer
+	require.NoError(t, s.SerializeStream(context.Background(), doc, &streamed, &native.SerializeOptions{}))
+	require.Contains(t, streamed.String(), `"diff":{"url":"https://example.com/CVE-2024-1234.patch"}`)
+
+	bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+	got, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+		&buf, &native.UnserializeOptions{}, nil,
+	)
+	require.NoError(t, err)
+
+	// The patch node carries the patch, no node is added for it
+	require.Len(t, got.NodeList.Nodes, 3)
+	require.Equal(t, []string{"openssl"}, got.NodeList.GetEdgeByType("fix", sbom.Edge_patch).To)
+	require.Equal(t, expected, patches(got))
+}
+
+func TestPedigreePatchNodes(t *testing.T) {
+	data := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,` +
+		`"metadata":{"component":{"bom-ref":"app","type":"application","name":"app"}},` +
+		`"components":[{"bom-ref":"openssl","type":"library","name":"openssl","pedigree":{"patches":[` +
+		`{"type":"backport","diff":{"url":"https://example.com/fix.patch"},` +
+		`"resolves":[{"type":"security","id":"CVE-2024-1234","references":["https://nvd.nist.gov/vuln/detail/CVE-2024-1234"]}]}]}}]}`
+
+	doc, err := NewCDX("1.5", "json").Unserialize(strings.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, doc.NodeList.Nodes, 3)
+
+	patch := doc.NodeList.GetNodeByID("openssl-patch-1")
+	require.NotNil(t, patch)
+	require.Equal(t, "backport patch", patch.Name)
+	require.Equal(t, []sbom.Purpose{sbom.Purpose_PATCH}, patch.PrimaryPurpose)
+	require.Len(t, patch.ExternalReferences, 2)
+	require.Equal(t, "https://example.com/fix.patch", patch.ExternalReferences[0].Url)
+	require.Equal(t, sbom.ExternalReference_SECURITY_ADVISORY, patch.ExternalReferences[1].Type)
+	require.Equal(t, "CVE-2024-1234", patch.ExternalReferences[1].Comment)
+	require.Equal(t, []string{"openssl"}, doc.NodeList.GetEdgeByType("openssl-patch-1", sbom.Edge_patch).To)
+}
+
+func TestLicenseExceptionRoundTrip(t *testing.T) {
+	for _, licenses := range [][]string{
+		{"Apache-2.0 WITH LLVM-exception"},
+		{"GPL-2.0-or-later WITH Classpath-exception-2.0 OR MIT"},
+	} {
+		doc := sbom.NewDocument()
+		doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app", Licenses: licenses})
+
+		s := serializers.NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding)
+		bom, err := s.Serialize(doc, &native.SerializeOptions{}, nil)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{Compact: true}, nil))
+		require.Contains(t, buf.String(), `"expression":"`+licenses[0]+`"`)
+
+		got, err := NewCDX(cdxUnserializerTestVersion, cdxUnserializerTestEncoding).Unserialize(
+			&buf, &native.UnserializeOptions{}, nil,
+		)
+		require.NoError(t, err)
+		require.Equal(t, licenses, got.NodeList.GetNodeByID("app").Licenses)
+	}
+}
+
+func TestLicenseEvidenceRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		Copyright: "Copyright 2024 Acme Inc.",
+		Licenses:  []string{"Apache-2.0"},
+	})
+
+	s := serializers.NewCDX("1.5", "json")
+	out, err := s.Serialize(doc, &native.SerializeOptions{LicenseEvidence: true}, nil)
+	require.NoError(t, err)
+
+	// Clear the native fields to read the data from the evidence only
+	bom := out.(*cdx.BOM)
+	require.NotNil(t, bom.Metadata.Component.Evidence)
+	bom.Metadata.Component.Copyright = ""
+	bom.Metadata.Component.Licenses = nil
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{Compact: true}, nil))
+	require.Contains(t, buf.String(), `"evidence":{"licenses":[{"license":{"id":"Apache-2.0"}}],"copyright":[{"text":"Copyright 2024 Acme Inc."}]}`)
+
+	newDoc, err := NewCDX("1.5", "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	node := newDoc.NodeList.GetNodeByID("app")
+	require.NotNil(t, node)
+	require.Equal(t, "Copyright 2024 Acme Inc.", node.Copyright)
+	require.Equal(t, []string{"Apache-2.0"}, node.Licenses)
+
+	// No evidence without the option
+	out, err = s.Serialize(doc, &native.SerializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, out.(*cdx.BOM).Metadata.Component.Evidence)
+}
+
+func TestMultipleLicensesRoundTrip(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app", Name: "app", Version: "1.0.0",
+		Licenses:         []string{"MIT", "Apache-2.0"},
+		LicenseConcluded: "MIT OR Apache-2.0",
+	})
+
+	s := serializers.NewCDX("1.5", "json")
+	for name, opts := range map[string]*native.SerializeOptions{
+		"licenses": {},
+		"evidence": {LicenseEvidence: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := s.Serialize(doc, opts, nil)
+			require.NoError(t, err)
+			bom := out.(*cdx.BOM)
+			require.Len(t, *bom.Metadata.Component.Licenses, 2)
+			if opts.LicenseEvidence {
+				// Read the licenses from the evidence only
+				bom.Metadata.Component.Licenses = nil
+			}
+
+			var buf bytes.Buffer
+			require.NoError(t, s.Render(bom, &buf, &native.RenderOptions{}, nil))
+			newDoc, err := NewCDX("1.5", "json").Unserialize(&buf, &native.UnserializeOptions{}, nil)
+			require.NoError(t, err)
+			node := newDoc.NodeList.GetNodeByID("app")
+			require.NotNil(t, node)
+			require.Equal(t, []string{"MIT", "Apache-2.0"}, node.Licenses)
+		})
+	}
+
+	// Licenses without an ID, like named ones, are skipped
+	node, err := NewCDX("1.5", "json").componentToNode(&cdx.Component{
+		BOMRef: "lib", Name: "lib",
+		Licenses: &cdx.Licenses{
+			{License: &cdx.License{Name: "Custom"}},
+			{},
+			{License: &cdx.License{ID: "MIT"}},
+			{Expression: "GPL-2.0-only WITH Classpath-exception-2.0"},
+		},
+	}, new(int))
+	require.NoError(t, err)
+	require.Equal(t, []string{"MIT", "GPL-2.0-only WITH Classpath-exception-2.0"}, node.Licenses)
+	require.Equal(t, "MIT OR (GPL-2.0-only WITH Classpath-exception-2.0)", node.LicenseConcluded)
+}
+
+func TestUnserializeNameVersionSeparators(t *testing.T) {
+	data := `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"components":[` +
+		`{"bom-ref":"a","type":"library","name":"foo@1.2.3"},` +
+		`{"bom-ref":"b","type":"library","name":"@scope/bar@2.0.0"},` +
+		`{"bom-ref":"c","type":"library","name":"baz@3.0.0","version":"3.0.0"}]}`
+
+	for _, tc := range []struct {
+		separators string
+		names      map[string][2]string
+	}{
+		{"", map[string][2]string{"a": {"foo@1.2.3", ""}, "b": {"@scope/bar@2.0.0", ""}, "c": {"baz@3.0.0", "3.0.0"}}},
+		{"@", map[string][2]string{"a": {"foo", "1.2.3"}, "b": {"@scope/bar", "2.0.0"}, "c": {"baz@3.0.0", "3.0.0"}}},
+	} {
+		doc, err := NewCDX("1.5", "json").Unserialize(
+			strings.NewReader(data), &native.UnserializeOptions{NameVersionSeparators: tc.separators}, nil,
+		)
+		require.NoError(t, err)
+		for id, nv := range tc.names {
+			node := doc.NodeList.GetNodeByID(id)
+			require.NotNil(t, node)
+			require.Equal(t, nv[0], node.Name)
+			require.Equal(t, nv[1], node.Version)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package unserializers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"strings"
 	"time"
 
+	"github.com/bom-squad/protobom/pkg/formats"
 	protospdx "github.com/bom-squad/protobom/pkg/formats/spdx"
 	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
@@ -27,15 +30,26 @@ func NewSPDX23() *SPDX23 {
 }
 
 // ParseStream reads an io.Reader to parse an SPDX 2.3 document from it
-func (u *SPDX23) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
-	spdxDoc, err := spdxjson.Read(r)
+func (u *SPDX23) Unserialize(r io.Reader, opts *native.UnserializeOptions, _ interface{}) (*sbom.Document, error) {
+	// The input is hashed as it is read to record its digest
+	hash := sha256.New()
+	source := io.TeeReader(r, hash)
+
+	spdxDoc, err := spdxjson.Read(source)
 	if err != nil {
 		return nil, fmt.Errorf("parsing SPDX json: %w", err)
 	}
 
+	// The decoder may stop at the end of the document, hash what is left
+	if _, err := io.Copy(io.Discard, source); err != nil {
+		return nil, fmt.Errorf("reading SPDX json: %w", err)
+	}
+
 	bom := sbom.NewDocument()
+	bom.Metadata.SourceSha256 = hex.EncodeToString(hash.Sum(nil))
 	bom.Metadata.Id = string(spdxDoc.SPDXIdentifier)
 	bom.Metadata.Name = spdxDoc.DocumentName
+	bom.Metadata.SourceFormat = string(formats.SPDX23JSON)
 
 	// TODO(degradation): External document references
 
@@ -78,6 +92,12 @@ func (u *SPDX23) Unserialize(r io.Reader, _ *native.UnserializeOptions, _ interf
 		}
 	}
 
+	opts.SplitNameVersions(bom)
+
+	if _, err := opts.DedupNodes(bom); err != nil {
+		return nil, fmt.Errorf("merging duplicate nodes: %w", err)
+	}
+
 	return bom, nil
 }
 
@@ -171,6 +191,12 @@ func (u *SPDX23) packageToNode(p *spdx23.Package) *sbom.Node {
 			// If it is a software identifier, catch it and continue:
 			idType := u.extRefTypeToIdentifierType(r.RefType)
 			if idType != sbom.SoftwareIdentifierType_UNKNOWN_IDENTIFIER_TYPE {
+				// Packages can have more than one purl, the first one is
+				// the primary and the rest are captured as alternates
+				if _, ok := n.Identifiers[int32(idType)]; ok && idType == sbom.SoftwareIdentifierType_PURL {
+					n.AlternatePurls = append(n.AlternatePurls, r.Locator)
+					continue
+				}
 				n.Identifiers[int32(idType)] = r.Locator
 				continue
 			}
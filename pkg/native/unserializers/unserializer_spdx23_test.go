@@ -1,9 +1,13 @@
 package unserializers
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/bom-squad/protobom/pkg/native"
 	"github.com/bom-squad/protobom/pkg/sbom"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/stretchr/testify/require"
@@ -115,3 +119,19 @@ func TestExtRefTypeToIdentifierType(t *testing.T) {
 		require.Equal(t, tc.expected, identifier)
 	}
 }
+
+func TestSPDXSourceSHA256(t *testing.T) {
+	data, err := os.ReadFile("../../../test/conformance/testdata/spdx/2.3/json/curl.spdx.json")
+	require.NoError(t, err)
+
+	spdxu := NewSPDX23()
+	doc, err := spdxu.Unserialize(bytes.NewReader(data), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256(data)), doc.Metadata.SourceSha256)
+
+	// The digest covers the whole input, not only the decoded document
+	padded := append(data, "\n\n"...)
+	doc, err = spdxu.Unserialize(bytes.NewReader(padded), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256(padded)), doc.Metadata.SourceSha256)
+}
@@ -110,6 +110,11 @@ func (n *Node) Diff(n2 *Node) *NodeDiff {
 	nd.Removed.ValidUntilDate = removedD
 	nd.DiffCount += count
 
+	addedRN, removedRN, count := diffReleaseNotes(n.ReleaseNotes, n2.ReleaseNotes)
+	nd.Added.ReleaseNotes = addedRN
+	nd.Removed.ReleaseNotes = removedRN
+	nd.DiffCount += count
+
 	added, removed, count := diffSlice(n.Licenses, n2.Licenses)
 	nd.Added.Licenses = added
 	nd.Removed.Licenses = removed
@@ -125,6 +130,11 @@ func (n *Node) Diff(n2 *Node) *NodeDiff {
 	nd.Removed.FileTypes = removed
 	nd.DiffCount += count
 
+	added, removed, count = diffSlice(n.AlternatePurls, n2.AlternatePurls)
+	nd.Added.AlternatePurls = added
+	nd.Removed.AlternatePurls = removed
+	nd.DiffCount += count
+
 	addedP, removedP, count := diffList(n.Suppliers, n2.Suppliers)
 	nd.Added.Suppliers = addedP
 	nd.Removed.Suppliers = removedP
@@ -135,11 +145,26 @@ func (n *Node) Diff(n2 *Node) *NodeDiff {
 	nd.Removed.Originators = removedP
 	nd.DiffCount += count
 
+	addedP, removedP, count = diffList(n.Manufacturers, n2.Manufacturers)
+	nd.Added.Manufacturers = addedP
+	nd.Removed.Manufacturers = removedP
+	nd.DiffCount += count
+
 	addedER, removedER, count := diffList(n.ExternalReferences, n2.ExternalReferences)
 	nd.Added.ExternalReferences = addedER
 	nd.Removed.ExternalReferences = removedER
 	nd.DiffCount += count
 
+	addedPr, removedPr, count := diffList(n.Properties, n2.Properties)
+	nd.Added.Properties = addedPr
+	nd.Removed.Properties = removedPr
+	nd.DiffCount += count
+
+	addedOc, removedOc, count := diffList(n.Occurrences, n2.Occurrences)
+	nd.Added.Occurrences = addedOc
+	nd.Removed.Occurrences = removedOc
+	nd.DiffCount += count
+
 	addedM, removedM, count := diffMap(n.Identifiers, n2.Identifiers)
 	nd.Added.Identifiers = addedM
 	nd.Removed.Identifiers = removedM
@@ -231,6 +256,21 @@ func diffDates(dt1, dt2 *timestamppb.Timestamp) (added, removed *timestamppb.Tim
 	return nil, nil, 0
 }
 
+// diffReleaseNotes compares two release notes by their flat strings and
+// returns rn2 in added if there is a change, rn1 in removed if rn2 is nil.
+// count will be 1 if there was a change.
+func diffReleaseNotes(rn1, rn2 *ReleaseNotes) (added, removed *ReleaseNotes, count int) {
+	switch {
+	case rn1 == nil && rn2 == nil:
+		return nil, nil, 0
+	case rn2 == nil:
+		return nil, rn1, 1
+	case rn1 == nil || rn1.flatString() != rn2.flatString():
+		return rn2, nil, 1
+	}
+	return nil, nil, 0
+}
+
 // diffMap compares two maps and returns what was added and removed
 func diffMap[K comparable, V comparable](map1, map2 map[K]V) (added, removed map[K]V, count int) {
 	added = make(map[K]V)
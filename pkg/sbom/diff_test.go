@@ -205,6 +205,52 @@ func TestNodeDiff(t *testing.T) {
 				DiffCount: 1,
 			},
 		},
+		{
+			name: "add release notes",
+			prepare: func(sutNode, newNode *Node) {
+				newNode.ReleaseNotes = &ReleaseNotes{Type: "major", Title: "1.0.0"}
+			},
+			expected: &NodeDiff{
+				Added: &Node{
+					ReleaseNotes: &ReleaseNotes{Type: "major", Title: "1.0.0"},
+				},
+				Removed:   &Node{},
+				DiffCount: 1,
+			},
+		},
+		{
+			name: "change release notes",
+			prepare: func(sutNode, newNode *Node) {
+				sutNode.ReleaseNotes = &ReleaseNotes{Type: "major", Title: "1.0.0"}
+				newNode.ReleaseNotes = &ReleaseNotes{
+					Type: "major", Title: "1.0.0",
+					Notes: []*ReleaseNote{{Locale: "en-US", Text: "First release"}},
+				}
+			},
+			expected: &NodeDiff{
+				Added: &Node{
+					ReleaseNotes: &ReleaseNotes{
+						Type: "major", Title: "1.0.0",
+						Notes: []*ReleaseNote{{Locale: "en-US", Text: "First release"}},
+					},
+				},
+				Removed:   &Node{},
+				DiffCount: 1,
+			},
+		},
+		{
+			name: "remove release notes",
+			prepare: func(sutNode, newNode *Node) {
+				sutNode.ReleaseNotes = &ReleaseNotes{Type: "major", Title: "1.0.0"}
+			},
+			expected: &NodeDiff{
+				Added: &Node{},
+				Removed: &Node{
+					ReleaseNotes: &ReleaseNotes{Type: "major", Title: "1.0.0"},
+				},
+				DiffCount: 1,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			// Tests start with a copy of the test node
@@ -18,6 +18,12 @@
 // serialzers.
 package sbom
 
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
 // NewDocument Creates a new empty document.
 func NewDocument() *Document {
 	return &Document{
@@ -37,8 +43,293 @@ func NewDocument() *Document {
 	}
 }
 
+// ErrorRefCollision is returned when rebasing the refs of a document maps
+// two different identifiers to the same new one.
+var ErrorRefCollision = fmt.Errorf("identifier collision")
+
 // GetRootNodes returns the top level nodes of the document. It calls the underlying
 // method in the document's NodeList.
 func (d *Document) GetRootNodes() []*Node {
 	return d.NodeList.GetRootNodes()
 }
+
+// AllIdentifiers returns the software identifiers of all the nodes in the
+// document grouped by type. Identifiers are listed once per type in the order
+// they are first found. Alternate purls are listed with the PURL identifiers.
+func (d *Document) AllIdentifiers() map[SoftwareIdentifierType][]string {
+	ret := map[SoftwareIdentifierType][]string{}
+	seen := map[SoftwareIdentifierType]map[string]struct{}{}
+
+	add := func(t SoftwareIdentifierType, value string) {
+		if value == "" {
+			return
+		}
+		if _, ok := seen[t]; !ok {
+			seen[t] = map[string]struct{}{}
+		}
+		if _, ok := seen[t][value]; ok {
+			return
+		}
+		seen[t][value] = struct{}{}
+		ret[t] = append(ret[t], value)
+	}
+
+	for _, n := range d.GetNodeList().GetNodes() {
+		types := make([]int32, 0, len(n.Identifiers))
+		for t := range n.Identifiers {
+			types = append(types, t)
+		}
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+		for _, t := range types {
+			add(SoftwareIdentifierType(t), n.Identifiers[t])
+		}
+		for _, purl := range n.AlternatePurls {
+			add(SoftwareIdentifierType_PURL, purl)
+		}
+	}
+
+	return ret
+}
+
+// PruneUnreachable removes the nodes that cannot be reached from the root
+// elements of the document following its edges. The edges of the removed
+// nodes are dropped too. It returns the number of nodes removed.
+func (d *Document) PruneUnreachable() int {
+	nl := d.GetNodeList()
+	if nl == nil {
+		return 0
+	}
+
+	edges := map[string][]string{}
+	for _, e := range nl.Edges {
+		edges[e.From] = append(edges[e.From], e.To...)
+	}
+
+	reachable := map[string]struct{}{}
+	queue := append([]string{}, nl.RootElements...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := reachable[id]; ok {
+			continue
+		}
+		reachable[id] = struct{}{}
+		queue = append(queue, edges[id]...)
+	}
+
+	unreachable := []string{}
+	for _, n := range nl.Nodes {
+		if _, ok := reachable[n.Id]; !ok {
+			unreachable = append(unreachable, n.Id)
+		}
+	}
+	if len(unreachable) == 0 {
+		return 0
+	}
+
+	nl.RemoveNodes(unreachable)
+	return len(unreachable)
+}
+
+// TruncateNodes removes nodes from the document until it has at most max
+// nodes. The nodes kept are picked walking the graph breadth first from the
+// root elements, so the truncated document stays connected; the unreachable
+// nodes are the first to go. It returns the number of nodes removed.
+func (d *Document) TruncateNodes(max int) int {
+	nl := d.GetNodeList()
+	if nl == nil || max < 0 || len(nl.Nodes) <= max {
+		return 0
+	}
+
+	edges := map[string][]string{}
+	for _, e := range nl.Edges {
+		edges[e.From] = append(edges[e.From], e.To...)
+	}
+	nodes := nl.indexNodes()
+
+	kept := map[string]struct{}{}
+	queue := append([]string{}, nl.RootElements...)
+	for len(queue) > 0 && len(kept) < max {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := kept[id]; ok {
+			continue
+		}
+		if _, ok := nodes[id]; !ok {
+			continue
+		}
+		kept[id] = struct{}{}
+		queue = append(queue, edges[id]...)
+	}
+
+	removed := []string{}
+	for _, n := range nl.Nodes {
+		if _, ok := kept[n.Id]; ok {
+			continue
+		}
+		if len(kept) < max {
+			kept[n.Id] = struct{}{}
+			continue
+		}
+		removed = append(removed, n.Id)
+	}
+
+	nl.RemoveNodes(removed)
+	nl.RootElements = slices.DeleteFunc(nl.RootElements, func(id string) bool {
+		_, ok := kept[id]
+		return !ok
+	})
+	return len(removed)
+}
+
+// RebaseRefs rewrites the identifiers of all nodes in the document using the
+// rebase function. The edges and root elements referencing the nodes are
+// updated accordingly. The new identifiers are computed before changing
+// anything: if two identifiers map to the same value or any of them maps to
+// an empty string an error is returned and the document is left untouched.
+func (d *Document) RebaseRefs(rebase func(old string) string) error {
+	nl := d.GetNodeList()
+	if nl == nil {
+		return nil
+	}
+
+	newRefs := map[string]string{}
+	owners := map[string]string{}
+	mapRef := func(old string) error {
+		if _, ok := newRefs[old]; ok {
+			return nil
+		}
+		ref := rebase(old)
+		if ref == "" {
+			return fmt.Errorf("identifier %q maps to an empty string", old)
+		}
+		if prev, ok := owners[ref]; ok {
+			return fmt.Errorf("%w: %q and %q both map to %q", ErrorRefCollision, prev, old, ref)
+		}
+		owners[ref] = old
+		newRefs[old] = ref
+		return nil
+	}
+
+	for _, n := range nl.Nodes {
+		if err := mapRef(n.Id); err != nil {
+			return err
+		}
+	}
+	for _, e := range nl.Edges {
+		if err := mapRef(e.From); err != nil {
+			return err
+		}
+		for _, id := range e.To {
+			if err := mapRef(id); err != nil {
+				return err
+			}
+		}
+	}
+	for _, id := range nl.RootElements {
+		if err := mapRef(id); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range nl.Nodes {
+		n.Id = newRefs[n.Id]
+	}
+	for _, e := range nl.Edges {
+		e.From = newRefs[e.From]
+		to := make([]string, 0, len(e.To))
+		for _, id := range e.To {
+			to = append(to, newRefs[id])
+		}
+		e.To = to
+	}
+	for i, id := range nl.RootElements {
+		nl.RootElements[i] = newRefs[id]
+	}
+
+	return nil
+}
+
+// MergeDuplicateNodes merges the nodes of the document that share a key
+// returned by the keys function. Each group of duplicates is folded into the
+// first node seen: hashes, licenses and alternate purls are unioned, empty
+// fields are filled in from the duplicates and their edges and root element
+// entries are moved to the surviving node. Empty keys are ignored. It
+// returns the number of nodes removed.
+func (d *Document) MergeDuplicateNodes(keys func(*Node) []string) int {
+	nl := d.GetNodeList()
+	if nl == nil {
+		return 0
+	}
+
+	owners := map[string]*Node{}
+	merged := map[string]string{}
+	for _, n := range nl.Nodes {
+		nodeKeys := keys(n)
+
+		var target *Node
+		for _, k := range nodeKeys {
+			if o, ok := owners[k]; ok && k != "" {
+				target = o
+				break
+			}
+		}
+
+		if target == nil {
+			target = n
+		} else {
+			target.merge(n)
+			merged[n.Id] = target.Id
+		}
+
+		for _, k := range nodeKeys {
+			if _, ok := owners[k]; !ok && k != "" {
+				owners[k] = target
+			}
+		}
+	}
+
+	if len(merged) == 0 {
+		return 0
+	}
+
+	for _, e := range nl.Edges {
+		if id, ok := merged[e.From]; ok {
+			e.From = id
+		}
+		to := make([]string, 0, len(e.To))
+		for _, id := range e.To {
+			if newID, ok := merged[id]; ok {
+				id = newID
+			}
+			// Edges between duplicates collapse into self references
+			if id == e.From {
+				continue
+			}
+			to = append(to, id)
+		}
+		e.To = to
+	}
+
+	roots := []string{}
+	seenRoots := map[string]struct{}{}
+	for _, id := range nl.RootElements {
+		if newID, ok := merged[id]; ok {
+			id = newID
+		}
+		if _, ok := seenRoots[id]; ok {
+			continue
+		}
+		seenRoots[id] = struct{}{}
+		roots = append(roots, id)
+	}
+	nl.RootElements = roots
+
+	ids := make([]string, 0, len(merged))
+	for id := range merged {
+		ids = append(ids, id)
+	}
+	nl.RemoveNodes(ids)
+	return len(ids)
+}
@@ -1,6 +1,14 @@
 package sbom_test
 
-import "github.com/bom-squad/protobom/pkg/sbom"
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
 
 // Demonstrates how to create a new protobom document and add multiple root nodes representing different software applications.
 // Each root node has distinct properties such as ID, name, version, licenses, etc. These root nodes are then attached to the document.
@@ -106,3 +114,180 @@ func Example_nodes() {
 	document.NodeList.AddNode(secondSecond)
 	document.NodeList.AddEdge(edge)
 }
+
+func TestAllIdentifiers(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{
+		Id: "app",
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_PURL):  "pkg:generic/app@1.0.0",
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*",
+		},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib1",
+		Identifiers: map[int32]string{
+			int32(sbom.SoftwareIdentifierType_PURL):  "pkg:npm/lib1@2.0.0",
+			int32(sbom.SoftwareIdentifierType_CPE22): "cpe:/a:example:lib1:2.0.0",
+		},
+		AlternatePurls: []string{"pkg:github/example/lib1@v2.0.0", "pkg:generic/app@1.0.0"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib2",
+		Identifiers: map[int32]string{
+			// Duplicated values are listed once
+			int32(sbom.SoftwareIdentifierType_PURL):  "pkg:npm/lib1@2.0.0",
+			int32(sbom.SoftwareIdentifierType_CPE23): "cpe:2.3:a:example:lib2:3.0.0:*:*:*:*:*:*:*",
+		},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "file", Type: sbom.Node_FILE})
+
+	require.Equal(t, map[sbom.SoftwareIdentifierType][]string{
+		sbom.SoftwareIdentifierType_PURL: {
+			"pkg:generic/app@1.0.0", "pkg:npm/lib1@2.0.0", "pkg:github/example/lib1@v2.0.0",
+		},
+		sbom.SoftwareIdentifierType_CPE22: {"cpe:/a:example:lib1:2.0.0"},
+		sbom.SoftwareIdentifierType_CPE23: {
+			"cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*", "cpe:2.3:a:example:lib2:3.0.0:*:*:*:*:*:*:*",
+		},
+	}, doc.AllIdentifiers())
+
+	require.Empty(t, sbom.NewDocument().AllIdentifiers())
+}
+
+func TestRebaseRefs(t *testing.T) {
+	newDoc := func() *sbom.Document {
+		doc := sbom.NewDocument()
+		doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+		doc.NodeList.AddNode(&sbom.Node{Id: "lib1", Name: "lib1"})
+		doc.NodeList.AddNode(&sbom.Node{Id: "lib2", Name: "lib2"})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1", "lib2"}})
+		doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+		return doc
+	}
+
+	t.Run("uuids", func(t *testing.T) {
+		doc := newDoc()
+		refs := map[string]string{}
+		require.NoError(t, doc.RebaseRefs(func(old string) string {
+			refs[old] = "urn:uuid:" + uuid.NewString()
+			return refs[old]
+		}))
+
+		require.Equal(t, []string{refs["app"]}, doc.NodeList.RootElements)
+		for _, n := range doc.NodeList.Nodes {
+			require.Equal(t, refs[n.Name], n.Id)
+		}
+		for _, e := range doc.NodeList.Edges {
+			require.NotNil(t, doc.NodeList.GetNodeByID(e.From))
+			for _, id := range e.To {
+				require.NotNil(t, doc.NodeList.GetNodeByID(id))
+			}
+		}
+		require.Equal(t, []string{refs["lib2"]}, doc.NodeList.GetEdgeByType(refs["lib1"], sbom.Edge_dependsOn).To)
+	})
+
+	t.Run("collision", func(t *testing.T) {
+		doc := newDoc()
+		err := doc.RebaseRefs(func(old string) string {
+			return strings.TrimRight(old, "12")
+		})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, sbom.ErrorRefCollision))
+
+		// The document is not modified
+		require.True(t, doc.NodeList.Equal(newDoc().NodeList))
+	})
+
+	t.Run("empty ref", func(t *testing.T) {
+		doc := newDoc()
+		require.Error(t, doc.RebaseRefs(func(string) string { return "" }))
+		require.True(t, doc.NodeList.Equal(newDoc().NodeList))
+	})
+}
+
+func TestPruneUnreachable(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"lib1", "lib2", "file1", "orphan1", "orphan2"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "lib2", To: []string{"file1"}})
+	// Edges from orphans do not make them reachable
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "orphan1", To: []string{"lib1", "orphan2"}})
+
+	require.Equal(t, 2, doc.PruneUnreachable())
+
+	ids := []string{}
+	for _, n := range doc.NodeList.Nodes {
+		ids = append(ids, n.Id)
+	}
+	require.Equal(t, []string{"app", "lib1", "lib2", "file1"}, ids)
+	require.Len(t, doc.NodeList.Edges, 3)
+	require.Nil(t, doc.NodeList.GetEdgeByType("orphan1", sbom.Edge_dependsOn))
+
+	require.Equal(t, 0, doc.PruneUnreachable())
+}
+
+func TestMergeDuplicateNodes(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib-a", Name: "lib", Licenses: []string{"MIT"},
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA1): "aaa"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{
+		Id: "lib-b", Name: "lib", Version: "1.0.0", Licenses: []string{"MIT", "Apache-2.0"},
+		Hashes: map[int32]string{int32(sbom.HashAlgorithm_SHA256): "bbb"},
+	})
+	doc.NodeList.AddNode(&sbom.Node{Id: "dep", Name: "dep"})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib-a", "lib-b"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib-b", To: []string{"dep", "lib-a"}})
+
+	byName := func(n *sbom.Node) []string {
+		if n.Name != "lib" {
+			return nil
+		}
+		return []string{n.Name}
+	}
+	require.Equal(t, 1, doc.MergeDuplicateNodes(byName))
+
+	require.Len(t, doc.NodeList.Nodes, 3)
+	require.Nil(t, doc.NodeList.GetNodeByID("lib-b"))
+
+	lib := doc.NodeList.GetNodeByID("lib-a")
+	require.Equal(t, "1.0.0", lib.Version)
+	require.Equal(t, []string{"MIT", "Apache-2.0"}, lib.Licenses)
+	require.Len(t, lib.Hashes, 2)
+
+	require.Equal(t, []string{"lib-a"}, doc.NodeList.GetEdgeByType("app", sbom.Edge_contains).To)
+	require.Equal(t, []string{"dep"}, doc.NodeList.GetEdgeByType("lib-a", sbom.Edge_dependsOn).To)
+
+	require.Equal(t, 0, doc.MergeDuplicateNodes(byName))
+}
+
+func TestTruncateNodes(t *testing.T) {
+	doc := sbom.NewDocument()
+	doc.NodeList.AddRootNode(&sbom.Node{Id: "app", Name: "app"})
+	for _, id := range []string{"orphan", "lib1", "lib2", "lib3"} {
+		doc.NodeList.AddNode(&sbom.Node{Id: id, Name: id})
+	}
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "app", To: []string{"lib1"}})
+	doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib2", "lib3"}})
+
+	require.Equal(t, 0, doc.TruncateNodes(5))
+
+	// Reachable nodes are kept first, in breadth first order
+	require.Equal(t, 2, doc.TruncateNodes(3))
+	ids := []string{}
+	for _, n := range doc.NodeList.Nodes {
+		ids = append(ids, n.Id)
+	}
+	require.Equal(t, []string{"app", "lib1", "lib2"}, ids)
+	require.Equal(t, []string{"lib2"}, doc.NodeList.GetEdgeByType("lib1", sbom.Edge_dependsOn).To)
+
+	require.Equal(t, 3, doc.TruncateNodes(0))
+	require.Empty(t, doc.NodeList.RootElements)
+}
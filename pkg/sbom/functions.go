@@ -187,3 +187,27 @@ func HashAlgorithmFromCDX(cdxAlgorithm cyclonedx.HashAlgorithm) HashAlgorithm {
 		return HashAlgorithm_UNKNOWN
 	}
 }
+
+// versionStartRe matches the start of a version string: a digit, optionally
+// after a "v" prefix.
+var versionStartRe = regexp.MustCompile(`^[vV]?[0-9]`)
+
+// SplitNameVersion splits a flat "name<sep>version" string (eg "foo@1.2.3"
+// or "foo:1.2.3") using the last occurrence of any of the separators. To
+// guard against names that contain a separator (eg "@scope/pkg" or
+// "user@example.com"), the string is only split when both parts are not
+// empty and the version starts like one, with a digit or a "v" followed by
+// a digit, and has no slashes. ok is false when the string was not split.
+func SplitNameVersion(s, separators string) (name, version string, ok bool) {
+	i := strings.LastIndexAny(s, separators)
+	if i <= 0 || i == len(s)-1 {
+		return s, "", false
+	}
+
+	// Separators are ASCII, the version starts right after it
+	name, version = s[:i], s[i+1:]
+	if !versionStartRe.MatchString(version) || strings.Contains(version, "/") {
+		return s, "", false
+	}
+	return name, version, true
+}
@@ -33,3 +33,31 @@ func TestNewNodeIdentifier(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitNameVersion(t *testing.T) {
+	for _, tc := range []struct {
+		input      string
+		separators string
+		name       string
+		version    string
+		ok         bool
+	}{
+		{"foo@1.2.3", "@", "foo", "1.2.3", true},
+		{"foo:1.2.3", ":@", "foo", "1.2.3", true},
+		{"foo@v2.0.0-rc1", "@", "foo", "v2.0.0-rc1", true},
+		{"@angular/core@16.2.0", "@", "@angular/core", "16.2.0", true},
+		{"@angular/core", "@", "@angular/core", "", false},
+		{"user@example.com", "@", "user@example.com", "", false},
+		{"foo@", "@", "foo@", "", false},
+		{"foo@1.2.3", ":", "foo@1.2.3", "", false},
+		{"docker.io/library/alpine:3.19", ":", "docker.io/library/alpine", "3.19", true},
+		{"localhost:5000/alpine", ":", "localhost:5000/alpine", "", false},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			name, version, ok := SplitNameVersion(tc.input, tc.separators)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.name, name)
+			require.Equal(t, tc.version, version)
+		})
+	}
+}
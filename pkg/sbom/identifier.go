@@ -20,6 +20,10 @@ func SoftwareIdentifierTypeFromString(queryString string) SoftwareIdentifierType
 		return SoftwareIdentifierType_CPE22
 	case "cpe23", "cpe2.3":
 		return SoftwareIdentifierType_CPE23
+	case "swid":
+		return SoftwareIdentifierType_SWID
+	case "swhid", "swh":
+		return SoftwareIdentifierType_SWHID
 	default:
 		return SoftwareIdentifierType_UNKNOWN_IDENTIFIER_TYPE
 	}
@@ -46,11 +50,11 @@ func SoftwareIdentifierTypeFromSPDXExtRefType(spdxType string) SoftwareIdentifie
 // ToSPDX2Category converts the external reference type to its SPDX2 category.
 func (i SoftwareIdentifierType) ToSPDX2Category() string {
 	switch i.ToSPDX2Type() {
-	case spdx.ExtRefTypeCPE22, spdx.ExtRefTypeCPE23, "advisory", "fix", "url", "swid":
+	case spdx.ExtRefTypeCPE22, spdx.ExtRefTypeCPE23, "advisory", "fix", "url", spdx.ExtRefTypeSwid:
 		return spdx.CategorySecurity
 	case "maven-central", "npm", "nuget", "bower", spdx.ExtRefTypePurl:
 		return spdx.CategoryPackageManager
-	case "swh", spdx.ExtRefTypeGitoid:
+	case spdx.ExtRefTypeSwh, spdx.ExtRefTypeGitoid:
 		return spdx.CategoryPersistentID
 	default:
 		return spdx.CategoryOther
@@ -68,6 +72,10 @@ func (i SoftwareIdentifierType) ToSPDX2Type() string {
 		return spdx.ExtRefTypeCPE23
 	case SoftwareIdentifierType_GITOID:
 		return spdx.ExtRefTypeGitoid
+	case SoftwareIdentifierType_SWID:
+		return spdx.ExtRefTypeSwid
+	case SoftwareIdentifierType_SWHID:
+		return spdx.ExtRefTypeSwh
 	default:
 		return ""
 	}
@@ -23,10 +23,14 @@ func NewNode() *Node {
 		Attribution:        []string{},
 		Suppliers:          []*Person{},
 		Originators:        []*Person{},
+		Manufacturers:      []*Person{},
 		ExternalReferences: []*ExternalReference{},
 		FileTypes:          []string{},
 		Identifiers:        map[int32]string{},
 		Hashes:             map[int32]string{},
+		AlternatePurls:     []string{},
+		Properties:         []*Property{},
+		Occurrences:        []*Occurrence{},
 	}
 }
 
@@ -106,6 +110,21 @@ func (n *Node) Update(n2 *Node) {
 	if len(n2.FileTypes) > 0 {
 		n.FileTypes = n2.FileTypes
 	}
+	if len(n2.AlternatePurls) > 0 {
+		n.AlternatePurls = n2.AlternatePurls
+	}
+	if n2.ReleaseNotes != nil {
+		n.ReleaseNotes = n2.ReleaseNotes
+	}
+	if len(n2.Manufacturers) > 0 {
+		n.Manufacturers = n2.Manufacturers
+	}
+	if len(n2.Properties) > 0 {
+		n.Properties = n2.Properties
+	}
+	if len(n2.Occurrences) > 0 {
+		n.Occurrences = n2.Occurrences
+	}
 }
 
 // Augment takes updates fields in n with data from n2 which is not already defined
@@ -183,6 +202,51 @@ func (n *Node) Augment(n2 *Node) {
 	if len(n.FileTypes) == 0 && len(n2.FileTypes) > 0 {
 		n.FileTypes = n2.FileTypes
 	}
+	if len(n.AlternatePurls) == 0 && len(n2.AlternatePurls) > 0 {
+		n.AlternatePurls = n2.AlternatePurls
+	}
+	if n.ReleaseNotes == nil && n2.ReleaseNotes != nil {
+		n.ReleaseNotes = n2.ReleaseNotes
+	}
+	if len(n.Manufacturers) == 0 && len(n2.Manufacturers) > 0 {
+		n.Manufacturers = n2.Manufacturers
+	}
+	if len(n.Properties) == 0 && len(n2.Properties) > 0 {
+		n.Properties = n2.Properties
+	}
+	if len(n.Occurrences) == 0 && len(n2.Occurrences) > 0 {
+		n.Occurrences = n2.Occurrences
+	}
+}
+
+// merge folds the data of a duplicate node n2 into n. Empty fields in n are
+// augmented from n2, then hashes, licenses and purls are unioned. Purls of n2
+// other than the primary purl of n are kept as alternate purls.
+func (n *Node) merge(n2 *Node) {
+	n.Augment(n2)
+
+	for algo, value := range n2.Hashes {
+		if _, ok := n.Hashes[algo]; ok {
+			continue
+		}
+		if n.Hashes == nil {
+			n.Hashes = map[int32]string{}
+		}
+		n.Hashes[algo] = value
+	}
+
+	for _, l := range n2.Licenses {
+		if !slices.Contains(n.Licenses, l) {
+			n.Licenses = append(n.Licenses, l)
+		}
+	}
+
+	for _, p := range n2.Purls() {
+		if p == n.Purl() || slices.Contains(n.AlternatePurls, string(p)) {
+			continue
+		}
+		n.AlternatePurls = append(n.AlternatePurls, string(p))
+	}
 }
 
 // Copy returns a duplicate of the Node.
@@ -208,12 +272,16 @@ func (n *Node) Copy() *Node {
 		Attribution:        slices.Clone(n.Attribution),
 		Suppliers:          []*Person{},
 		Originators:        []*Person{},
+		Manufacturers:      []*Person{},
 		ReleaseDate:        nil,
 		BuildDate:          nil,
 		ValidUntilDate:     nil,
 		ExternalReferences: []*ExternalReference{},
 		Identifiers:        maps.Clone(n.Identifiers),
 		FileTypes:          slices.Clone(n.FileTypes),
+		AlternatePurls:     slices.Clone(n.AlternatePurls),
+		Properties:         []*Property{},
+		Occurrences:        []*Occurrence{},
 	}
 
 	if n.ReleaseDate != nil {
@@ -232,9 +300,21 @@ func (n *Node) Copy() *Node {
 	for _, p := range n.Originators {
 		no.Originators = append(no.Originators, p.Copy())
 	}
+	for _, p := range n.Manufacturers {
+		no.Manufacturers = append(no.Manufacturers, p.Copy())
+	}
 	for _, e := range n.ExternalReferences {
 		no.ExternalReferences = append(no.ExternalReferences, e.Copy())
 	}
+	for _, p := range n.Properties {
+		no.Properties = append(no.Properties, p.Copy())
+	}
+	for _, o := range n.Occurrences {
+		no.Occurrences = append(no.Occurrences, o.Copy())
+	}
+	if n.ReleaseNotes != nil {
+		no.ReleaseNotes = n.ReleaseNotes.Copy()
+	}
 
 	return no
 }
@@ -265,6 +345,18 @@ func (n *Node) flatString() string {
 			for _, i := range n.Originators {
 				pairs = append(pairs, fmt.Sprintf("originator:%s", i.flatString()))
 			}
+		case "bomsquad.protobom.Node.manufacturers":
+			for _, i := range n.Manufacturers {
+				pairs = append(pairs, fmt.Sprintf("manufacturer:%s", i.flatString()))
+			}
+		case "bomsquad.protobom.Node.properties":
+			for _, p := range n.Properties {
+				pairs = append(pairs, fmt.Sprintf("property:%s", p.flatString()))
+			}
+		case "bomsquad.protobom.Node.occurrences":
+			for _, o := range n.Occurrences {
+				pairs = append(pairs, fmt.Sprintf("occurrence:%s", o.flatString()))
+			}
 		case "bomsquad.protobom.Node.identifiers":
 			// Index the keys and sort them to make the string deterministic
 			idKeys := []int{}
@@ -275,6 +367,8 @@ func (n *Node) flatString() string {
 			for _, t := range idKeys {
 				pairs = append(pairs, fmt.Sprintf("identifiers[%d]:%s", t, n.Identifiers[int32(t)]))
 			}
+		case "bomsquad.protobom.Node.release_notes":
+			pairs = append(pairs, fmt.Sprintf("releasenotes:%s", n.ReleaseNotes.flatString()))
 		case "bomsquad.protobom.Node.release_date":
 			if n.ReleaseDate != nil {
 				pairs = append(pairs, fmt.Sprintf("%s:%d", fd.FullName(), n.ReleaseDate.AsTime().Unix()))
@@ -292,6 +386,7 @@ func (n *Node) flatString() string {
 		case "bomsquad.protobom.Node.licenses",
 			"bomsquad.protobom.Node.attribution",
 			"bomsquad.protobom.Node.file_types",
+			"bomsquad.protobom.Node.alternate_purls",
 			"bomsquad.protobom.Node.primary_purpose":
 			pairs = append(pairs, flatStringStrSlice(fd.FullName(), v.List()))
 
@@ -349,6 +444,21 @@ func (n *Node) Checksum() string {
 // PackageURL represents a Package URL (PURL) for identifying and locating software packages.
 type PackageURL string
 
+// SplitNameVersion splits the name of a node without a version when it
+// holds both, like "foo@1.2.3", see SplitNameVersion. It returns true if
+// the name was split.
+func (n *Node) SplitNameVersion(separators string) bool {
+	if n.Version != "" {
+		return false
+	}
+	name, version, ok := SplitNameVersion(n.Name, separators)
+	if !ok {
+		return false
+	}
+	n.Name, n.Version = name, version
+	return true
+}
+
 // Purl returns the node's Package URL (PURL) as a string.
 // If the node is of type FILE empty PURL is returned.
 func (n *Node) Purl() PackageURL {
@@ -363,6 +473,24 @@ func (n *Node) Purl() PackageURL {
 	return ""
 }
 
+// Purls returns all the Package URLs of the node. The primary PURL from the
+// identifiers map is returned first, followed by the alternate PURLs.
+func (n *Node) Purls() []PackageURL {
+	purls := []PackageURL{}
+	if p := n.Purl(); p != "" {
+		purls = append(purls, p)
+	}
+
+	if n.Type == Node_FILE {
+		return purls
+	}
+
+	for _, p := range n.AlternatePurls {
+		purls = append(purls, PackageURL(p))
+	}
+	return purls
+}
+
 // HashesMatch checks if the provided test-hashes (th) match those of the node.
 // It only considers common algorithms between the node and the test hashes.
 //
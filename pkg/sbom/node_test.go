@@ -463,3 +463,48 @@ func TestNodeAddHash(t *testing.T) {
 		})
 	}
 }
+
+func TestNodePurls(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sut      *Node
+		expected []PackageURL
+	}{
+		{
+			name:     "no purls",
+			sut:      &Node{},
+			expected: []PackageURL{},
+		},
+		{
+			name: "primary only",
+			sut: &Node{Identifiers: map[int32]string{
+				int32(SoftwareIdentifierType_PURL): "pkg:deb/debian/curl@7.88.1",
+			}},
+			expected: []PackageURL{"pkg:deb/debian/curl@7.88.1"},
+		},
+		{
+			name: "primary and alternates",
+			sut: &Node{
+				Identifiers: map[int32]string{
+					int32(SoftwareIdentifierType_PURL): "pkg:deb/debian/curl@7.88.1",
+				},
+				AlternatePurls: []string{"pkg:deb/ubuntu/curl@7.88.1", "pkg:rpm/fedora/curl@7.88.1"},
+			},
+			expected: []PackageURL{
+				"pkg:deb/debian/curl@7.88.1", "pkg:deb/ubuntu/curl@7.88.1", "pkg:rpm/fedora/curl@7.88.1",
+			},
+		},
+		{
+			name: "file node",
+			sut: &Node{
+				Type:           Node_FILE,
+				AlternatePurls: []string{"pkg:deb/ubuntu/curl@7.88.1"},
+			},
+			expected: []PackageURL{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.sut.Purls())
+		})
+	}
+}
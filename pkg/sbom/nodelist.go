@@ -3,6 +3,7 @@ package sbom
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -29,6 +30,8 @@ type purlIndex map[PackageURL][]*Node
 
 var ErrorMoreThanOneMatch = fmt.Errorf("more than one node matches")
 
+var ErrorDependencyCycle = fmt.Errorf("dependency cycle detected")
+
 // NewNodeList returns a new NodeList with empty nodes, edges, and root elements.
 func NewNodeList() *NodeList {
 	return &NodeList{
@@ -105,7 +108,8 @@ func (nl *NodeList) indexNodesByPurl() map[PackageURL][]*Node {
 }
 
 // cleanEdges is a utility function that removes broken
-// connection and orphaned edges
+// connection and orphaned edges. Equivalent edges are merged
+// preserving the order in which they were first seen.
 func (nl *NodeList) cleanEdges() {
 	// Build a catalog of the elements ids
 	nodeIndex := nl.indexNodes()
@@ -113,7 +117,8 @@ func (nl *NodeList) cleanEdges() {
 	// Add a seen cache to dedupe edges when
 	// cleaning them up
 	seenCache := map[string]*Edge{}
-	newTos := map[string]map[string]string{}
+	seenTos := map[string]map[string]struct{}{}
+	newEdges := []*Edge{}
 
 	// Now list all edges and rebuild the list
 	for _, edge := range nl.Edges {
@@ -124,9 +129,6 @@ func (nl *NodeList) cleanEdges() {
 
 		// Use a string key for a simpler datastruct
 		edgeKey := edge.From + "+++" + edge.Type.String()
-		if _, ok := newTos[edgeKey]; !ok {
-			newTos[edgeKey] = map[string]string{}
-		}
 
 		// If we already saw an equivalent edge, reuse it
 		if _, ok := seenCache[edgeKey]; !ok {
@@ -135,27 +137,25 @@ func (nl *NodeList) cleanEdges() {
 				From: edge.From,
 				To:   []string{},
 			}
+			seenTos[edgeKey] = map[string]struct{}{}
+			newEdges = append(newEdges, seenCache[edgeKey])
 		}
 
 		for _, s := range edge.To {
 			if _, ok := nodeIndex[s]; !ok {
 				continue
 			}
-			newTos[edgeKey][s] = s
-		}
-	}
-
-	newEdges := []*Edge{}
-	for f := range seenCache {
-		for s := range newTos[f] {
-			seenCache[f].To = append(seenCache[f].To, s)
-		}
-		if len(seenCache[f].To) > 0 {
-			newEdges = append(newEdges, seenCache[f])
+			if _, ok := seenTos[edgeKey][s]; ok {
+				continue
+			}
+			seenTos[edgeKey][s] = struct{}{}
+			seenCache[edgeKey].To = append(seenCache[edgeKey].To, s)
 		}
 	}
 
-	nl.Edges = newEdges
+	nl.Edges = slices.DeleteFunc(newEdges, func(e *Edge) bool {
+		return len(e.To) == 0
+	})
 }
 
 // AddEdge adds a new edge to the Node List.
@@ -246,6 +246,39 @@ func (nl *NodeList) RemoveNodes(ids []string) {
 	nl.cleanEdges()
 }
 
+// DuplicateNodeIDs returns the IDs shared by more than one node of the
+// NodeList, in the order they are first repeated.
+func (nl *NodeList) DuplicateNodeIDs() []string {
+	dupes := []string{}
+	seen := map[string]int{}
+	for _, n := range nl.GetNodes() {
+		seen[n.Id]++
+		if seen[n.Id] == 2 {
+			dupes = append(dupes, n.Id)
+		}
+	}
+	return dupes
+}
+
+// MergeDuplicateIDs folds the nodes that share an ID into the first one
+// with it, as MergeDuplicateNodes does. The edges need no changes as the
+// duplicates have the same ID. It returns the number of nodes removed.
+func (nl *NodeList) MergeDuplicateIDs() int {
+	first := map[string]*Node{}
+	nodes := make([]*Node, 0, len(nl.Nodes))
+	for _, n := range nl.Nodes {
+		if target, ok := first[n.Id]; ok {
+			target.merge(n)
+			continue
+		}
+		first[n.Id] = n
+		nodes = append(nodes, n)
+	}
+	removed := len(nl.Nodes) - len(nodes)
+	nl.Nodes = nodes
+	return removed
+}
+
 // GetEdgeByType returns the first edge of the specified type (t) originating from the given node ID (fromElement).
 // If no such edge is found, it returns nil.
 func (nl *NodeList) GetEdgeByType(fromElement string, t Edge_Type) *Edge {
@@ -639,7 +672,8 @@ func (nl *NodeList) RelateNodeAtID(n *Node, nodeID string, edgeType Edge_Type) e
 // with the specified ID (nodeID) using a relationship of the given type (edgeType).
 // It returns an error if ID cannot be found in the graph.
 // Nodes with the same ID in both the current (nl) and provided (nl2) Node Lists
-// are considered equivalent and will be deduplicated.
+// are considered equivalent and will be deduplicated. The edges of nl2 are
+// carried over too.
 func (nl *NodeList) RelateNodeListAtID(nl2 *NodeList, nodeID string, edgeType Edge_Type) error {
 	// Check the node exists
 	nlIndex := nl.indexNodes()
@@ -676,6 +710,10 @@ func (nl *NodeList) RelateNodeListAtID(nl2 *NodeList, nodeID string, edgeType Ed
 		nl.AddNode(n)
 	}
 
+	for _, e := range nl2.Edges {
+		nl.AddEdge(e.Copy())
+	}
+
 	return nil
 }
 
@@ -924,3 +962,64 @@ func (nl *NodeList) NodeDescendants(id string, maxDepth int) *NodeList {
 	nl2.cleanEdges()
 	return &nl2
 }
+
+// TopoSort returns the nodes of the NodeList in topological order over their
+// dependsOn edges: every node is listed after all the nodes it depends on.
+// Nodes without dependency relationships keep their relative order in the
+// NodeList. Edges pointing to nodes not in the list are ignored. If the
+// dependency graph has a cycle, an error wrapping ErrorDependencyCycle is
+// returned listing the nodes in the cycle.
+func (nl *NodeList) TopoSort() ([]*Node, error) {
+	nodeIdx := nl.indexNodes()
+	edgeIdx := nl.indexEdges()
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(nl.Nodes))
+	sorted := make([]*Node, 0, len(nl.Nodes))
+	path := []string{}
+
+	var visit func(n *Node) error
+	visit = func(n *Node) error {
+		switch state[n.Id] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, n.Id)
+			cycle := append(slices.Clone(path[start:]), n.Id)
+			return fmt.Errorf("%w: %s", ErrorDependencyCycle, strings.Join(cycle, " -> "))
+		}
+
+		state[n.Id] = visiting
+		path = append(path, n.Id)
+
+		for _, e := range edgeIdx[n.Id][Edge_dependsOn] {
+			for _, id := range e.To {
+				dep, ok := nodeIdx[id]
+				if !ok {
+					continue
+				}
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[n.Id] = visited
+		sorted = append(sorted, n)
+		return nil
+	}
+
+	for _, n := range nl.Nodes {
+		if err := visit(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
@@ -100,6 +100,24 @@ func TestCleanEdges(t *testing.T) {
 	}
 }
 
+func TestCleanEdgesOrder(t *testing.T) {
+	sut := &NodeList{
+		Nodes: []*Node{
+			{Id: "node1"}, {Id: "node2"}, {Id: "node3"}, {Id: "node4"},
+		},
+		Edges: []*Edge{
+			{Type: Edge_contains, From: "node1", To: []string{"node4", "node2"}},
+			{Type: Edge_dependsOn, From: "node2", To: []string{"node3"}},
+			{Type: Edge_contains, From: "node1", To: []string{"node3", "node2"}},
+		},
+	}
+	sut.cleanEdges()
+	require.Equal(t, []*Edge{
+		{Type: Edge_contains, From: "node1", To: []string{"node4", "node2", "node3"}},
+		{Type: Edge_dependsOn, From: "node2", To: []string{"node3"}},
+	}, sut.Edges)
+}
+
 func TestRemoveNodes(t *testing.T) {
 	for _, tc := range []struct {
 		sut      *NodeList
@@ -1276,6 +1294,67 @@ func TestRelateNodeAtId(t *testing.T) {
 	}
 }
 
+func TestRelateNodeListAtID(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		sut         *NodeList
+		nl2         *NodeList
+		expected    *NodeList
+		shouldError bool
+	}{
+		{
+			name: "relate top level nodes",
+			sut:  &NodeList{Nodes: []*Node{{Id: "root"}}},
+			nl2: &NodeList{
+				Nodes:        []*Node{{Id: "a"}, {Id: "b"}},
+				RootElements: []string{"a", "b"},
+			},
+			expected: &NodeList{
+				Nodes: []*Node{{Id: "root"}, {Id: "a"}, {Id: "b"}},
+				Edges: []*Edge{{From: "root", To: []string{"a", "b"}, Type: Edge_contains}},
+			},
+		},
+		{
+			name: "edges of the related list are carried over",
+			sut:  &NodeList{Nodes: []*Node{{Id: "root"}}},
+			nl2: &NodeList{
+				Nodes:        []*Node{{Id: "a"}, {Id: "b"}},
+				Edges:        []*Edge{{From: "a", To: []string{"b"}, Type: Edge_contains}},
+				RootElements: []string{"a"},
+			},
+			expected: &NodeList{
+				Nodes: []*Node{{Id: "root"}, {Id: "a"}, {Id: "b"}},
+				Edges: []*Edge{
+					{From: "root", To: []string{"a"}, Type: Edge_contains},
+					{From: "a", To: []string{"b"}, Type: Edge_contains},
+				},
+			},
+		},
+		{
+			name:        "non existent node",
+			sut:         &NodeList{Nodes: []*Node{{Id: "other"}}},
+			nl2:         &NodeList{Nodes: []*Node{{Id: "a"}}, RootElements: []string{"a"}},
+			shouldError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sut.RelateNodeListAtID(tc.nl2, "root", Edge_contains)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.sut.Equal(tc.expected))
+
+			// The edges are copied, changing nl2 does not alter the result
+			for _, e := range tc.nl2.Edges {
+				e.To = append(e.To, "changed")
+			}
+			require.True(t, tc.sut.Equal(tc.expected))
+		})
+	}
+}
+
 func TestNodeListCopy(t *testing.T) {
 	for _, tc := range []struct {
 		original *NodeList
@@ -1315,3 +1394,97 @@ func TestNodeListCopy(t *testing.T) {
 		require.True(t, tc.original.Equal(copied), "equal copied nodelist %s %s", tc.original, copied)
 	}
 }
+
+func TestTopoSort(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sut      *NodeList
+		expected []string
+		cycle    string
+	}{
+		{
+			name: "acyclic graph",
+			sut: &NodeList{
+				Nodes: []*Node{
+					{Id: "app"}, {Id: "lib1"}, {Id: "lib2"}, {Id: "lib3"}, {Id: "docs"},
+				},
+				Edges: []*Edge{
+					{Type: Edge_contains, From: "app", To: []string{"lib1", "lib2", "lib3", "docs"}},
+					{Type: Edge_dependsOn, From: "app", To: []string{"lib1", "lib2"}},
+					{Type: Edge_dependsOn, From: "lib1", To: []string{"lib3"}},
+					{Type: Edge_dependsOn, From: "lib2", To: []string{"lib3", "missing"}},
+				},
+			},
+			expected: []string{"lib3", "lib1", "lib2", "app", "docs"},
+		},
+		{
+			name: "no edges",
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}, {Id: "node2"}},
+			},
+			expected: []string{"node1", "node2"},
+		},
+		{
+			name: "cyclic graph",
+			sut: &NodeList{
+				Nodes: []*Node{
+					{Id: "app"}, {Id: "lib1"}, {Id: "lib2"}, {Id: "lib3"},
+				},
+				Edges: []*Edge{
+					{Type: Edge_dependsOn, From: "app", To: []string{"lib1"}},
+					{Type: Edge_dependsOn, From: "lib1", To: []string{"lib2"}},
+					{Type: Edge_dependsOn, From: "lib2", To: []string{"lib3"}},
+					{Type: Edge_dependsOn, From: "lib3", To: []string{"lib1"}},
+				},
+			},
+			cycle: "lib1 -> lib2 -> lib3 -> lib1",
+		},
+		{
+			name: "self dependency",
+			sut: &NodeList{
+				Nodes: []*Node{{Id: "node1"}},
+				Edges: []*Edge{{Type: Edge_dependsOn, From: "node1", To: []string{"node1"}}},
+			},
+			cycle: "node1 -> node1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted, err := tc.sut.TopoSort()
+			if tc.cycle != "" {
+				require.ErrorIs(t, err, ErrorDependencyCycle)
+				require.Contains(t, err.Error(), tc.cycle)
+				require.Nil(t, sorted)
+				return
+			}
+			require.NoError(t, err)
+			ids := []string{}
+			for _, n := range sorted {
+				ids = append(ids, n.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestMergeDuplicateIDs(t *testing.T) {
+	nl := &NodeList{
+		Nodes: []*Node{
+			{Id: "app", Name: "app"},
+			{Id: "lib", Name: "lib", Version: "1.0.0", Hashes: map[int32]string{int32(HashAlgorithm_SHA1): "abc"}},
+			{Id: "other", Name: "other"},
+			{Id: "lib", Name: "lib", Hashes: map[int32]string{int32(HashAlgorithm_SHA256): "def"}},
+			{Id: "other", Name: "other"},
+		},
+		Edges:        []*Edge{{Type: Edge_contains, From: "app", To: []string{"lib", "other"}}},
+		RootElements: []string{"app"},
+	}
+
+	require.Equal(t, []string{"lib", "other"}, nl.DuplicateNodeIDs())
+	require.Equal(t, 2, nl.MergeDuplicateIDs())
+	require.Empty(t, nl.DuplicateNodeIDs())
+	require.Len(t, nl.Nodes, 3)
+	require.Equal(t, "1.0.0", nl.Nodes[1].Version)
+	require.Len(t, nl.Nodes[1].Hashes, 2)
+	require.Equal(t, []string{"lib", "other"}, nl.Edges[0].To)
+	require.Equal(t, 0, nl.MergeDuplicateIDs())
+}
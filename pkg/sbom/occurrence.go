@@ -0,0 +1,22 @@
+package sbom
+
+import "fmt"
+
+// flatString returns a deterministic serialized representation of the occurrence as a string.
+// The resulting string is suitable for indexing or generating a hash.
+func (o *Occurrence) flatString() string {
+	return fmt.Sprintf(
+		"(l)%s(n)%d(o)%d(s)%s(c)%s", o.Location, o.Line, o.Offset, o.Symbol, o.AdditionalContext,
+	)
+}
+
+// Copy returns an exact duplicate of the occurrence.
+func (o *Occurrence) Copy() *Occurrence {
+	return &Occurrence{
+		Location:          o.Location,
+		Line:              o.Line,
+		Offset:            o.Offset,
+		Symbol:            o.Symbol,
+		AdditionalContext: o.AdditionalContext,
+	}
+}
@@ -0,0 +1,17 @@
+package sbom
+
+import "fmt"
+
+// flatString returns a deterministic serialized representation of the property as a string.
+// The resulting string is suitable for indexing or generating a hash.
+func (p *Property) flatString() string {
+	return fmt.Sprintf("(n)%s(v)%s", p.Name, p.Value)
+}
+
+// Copy returns an exact duplicate of the property.
+func (p *Property) Copy() *Property {
+	return &Property{
+		Name:  p.Name,
+		Value: p.Value,
+	}
+}
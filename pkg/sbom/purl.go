@@ -0,0 +1,163 @@
+package sbom
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Normalize returns the canonical form of the package URL as described in
+// the purl specification:
+//
+//   - The scheme and the type are lowercased.
+//   - The namespace, name, version, qualifier values and subpath are
+//     percent-encoded consistently.
+//   - The namespace and name are lowercased for the types that are case
+//     insensitive (bitbucket, github and pypi, which also replaces
+//     underscores with dashes in names).
+//   - Qualifier keys are lowercased, empty qualifiers are removed and the
+//     rest are sorted by key.
+//   - Empty, "." and ".." segments are removed from the subpath.
+//
+// Strings that cannot be parsed as a package URL are returned unchanged.
+func (purl PackageURL) Normalize() PackageURL {
+	rest := strings.TrimSpace(string(purl))
+	scheme, rest, ok := strings.Cut(rest, ":")
+	if !ok || !strings.EqualFold(scheme, "pkg") {
+		return purl
+	}
+	rest = strings.TrimLeft(rest, "/")
+
+	subpath := ""
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		subpath = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	qualifiers := ""
+	if i := strings.LastIndex(rest, "?"); i >= 0 {
+		qualifiers = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	ptype, rest, ok := strings.Cut(rest, "/")
+	if !ok || ptype == "" {
+		return purl
+	}
+	ptype = strings.ToLower(ptype)
+
+	version := ""
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		version = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	segments := []string{}
+	for _, s := range strings.Split(rest, "/") {
+		if s = unescapePurlComponent(s); s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) == 0 {
+		return purl
+	}
+
+	switch ptype {
+	case "bitbucket", "github":
+		for i := range segments {
+			segments[i] = strings.ToLower(segments[i])
+		}
+	case "pypi":
+		for i := range segments {
+			segments[i] = strings.ToLower(segments[i])
+		}
+		segments[len(segments)-1] = strings.ReplaceAll(segments[len(segments)-1], "_", "-")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("pkg:" + ptype)
+	for _, s := range segments {
+		sb.WriteString("/" + escapePurlComponent(s, ":+"))
+	}
+
+	if version != "" {
+		sb.WriteString("@" + escapePurlComponent(unescapePurlComponent(version), ":+"))
+	}
+
+	if q := normalizePurlQualifiers(qualifiers); q != "" {
+		sb.WriteString("?" + q)
+	}
+
+	subpathSegments := []string{}
+	for _, s := range strings.Split(subpath, "/") {
+		s = unescapePurlComponent(s)
+		if s == "" || s == "." || s == ".." {
+			continue
+		}
+		subpathSegments = append(subpathSegments, escapePurlComponent(s, ":+"))
+	}
+	if len(subpathSegments) > 0 {
+		sb.WriteString("#" + strings.Join(subpathSegments, "/"))
+	}
+
+	return PackageURL(sb.String())
+}
+
+// normalizePurlQualifiers returns the qualifiers string with its keys
+// lowercased and sorted and its empty values removed.
+func normalizePurlQualifiers(qualifiers string) string {
+	if qualifiers == "" {
+		return ""
+	}
+
+	values := map[string]string{}
+	keys := []string{}
+	for _, pair := range strings.Split(qualifiers, "&") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(key)
+		value = unescapePurlComponent(value)
+		if key == "" || value == "" {
+			continue
+		}
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+escapePurlComponent(values[k], ":/+"))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// unescapePurlComponent decodes the percent-encoded characters of a purl
+// component. If the string is not valid, it is returned as is.
+func unescapePurlComponent(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
+
+// escapePurlComponent percent-encodes all the characters in s except the
+// unreserved ones and those in keep.
+func escapePurlComponent(s, keep string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || strings.IndexByte(keep, c) >= 0 {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%%%02X", c))
+	}
+	return sb.String()
+}
@@ -0,0 +1,46 @@
+package sbom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageURLNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		purl     PackageURL
+		expected PackageURL
+	}{
+		{"canonical", "pkg:npm/%40angular/core@12.3.1", "pkg:npm/%40angular/core@12.3.1"},
+		{"uppercase scheme and type", "PKG:NPM/lodash@4.17.21", "pkg:npm/lodash@4.17.21"},
+		{"unencoded namespace", "pkg:npm/@angular/core@12.3.1", "pkg:npm/%40angular/core@12.3.1"},
+		{"lowercase hex", "pkg:generic/my%2fpkg@1.0", "pkg:generic/my%2Fpkg@1.0"},
+		{"needless escape", "pkg:maven/org%2Eapache/commons@1.0", "pkg:maven/org.apache/commons@1.0"},
+		{"case insensitive type", "pkg:GitHub/Package-URL/PurL-Spec@v1.0", "pkg:github/package-url/purl-spec@v1.0"},
+		{"pypi name", "pkg:PyPI/Django_Rest@3.0", "pkg:pypi/django-rest@3.0"},
+		{"version with epoch", "pkg:rpm/fedora/curl@1:7.50.3-1.fc25", "pkg:rpm/fedora/curl@1:7.50.3-1.fc25"},
+		{"version with plus", "pkg:deb/debian/curl@7.50+dfsg", "pkg:deb/debian/curl@7.50+dfsg"},
+		{
+			"qualifiers",
+			"pkg:deb/debian/curl@7.50?Distro=jessie&arch=&Arch=i386",
+			"pkg:deb/debian/curl@7.50?arch=i386&distro=jessie",
+		},
+		{
+			"qualifier url",
+			"pkg:generic/openssl@1.1.10g?download_url=https://openssl.org/source/openssl-1.1.0g.tar.gz",
+			"pkg:generic/openssl@1.1.10g?download_url=https://openssl.org/source/openssl-1.1.0g.tar.gz",
+		},
+		{"subpath", "pkg:golang/google.golang.org/genproto#/googleapis/./api//annotations/", "pkg:golang/google.golang.org/genproto#googleapis/api/annotations"},
+		{"extra slashes", "pkg://npm//lodash", "pkg:npm/lodash"},
+		{"not a purl", "https://example.com/lodash", "https://example.com/lodash"},
+		{"no name", "pkg:npm", "pkg:npm"},
+		{"empty", "", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.purl.Normalize())
+			// Normalization is idempotent
+			require.Equal(t, tc.expected, tc.expected.Normalize())
+		})
+	}
+}
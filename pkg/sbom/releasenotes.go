@@ -0,0 +1,56 @@
+package sbom
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// flatString returns a deterministic serialized representation of the release notes as a string.
+// The resulting string is suitable for indexing or generating a hash.
+func (rn *ReleaseNotes) flatString() string {
+	ret := fmt.Sprintf("(t)%s", rn.Type)
+
+	if rn.Title != "" {
+		ret += fmt.Sprintf("(ti)%s", rn.Title)
+	}
+
+	if rn.Description != "" {
+		ret += fmt.Sprintf("(d)%s", rn.Description)
+	}
+
+	if rn.Timestamp != nil {
+		ret += fmt.Sprintf("(ts)%d", rn.Timestamp.AsTime().Unix())
+	}
+
+	for _, n := range rn.Notes {
+		ret += fmt.Sprintf("(n)%s:%s:%s:%s", n.Locale, n.ContentType, n.Encoding, n.Text)
+	}
+
+	return ret
+}
+
+// Copy returns an exact duplicate of the release notes.
+func (rn *ReleaseNotes) Copy() *ReleaseNotes {
+	no := &ReleaseNotes{
+		Type:        rn.Type,
+		Title:       rn.Title,
+		Description: rn.Description,
+		Notes:       []*ReleaseNote{},
+	}
+
+	if rn.Timestamp != nil {
+		no.Timestamp = timestamppb.New(rn.Timestamp.AsTime())
+	}
+
+	for _, n := range rn.Notes {
+		no.Notes = append(no.Notes, &ReleaseNote{
+			Locale:      n.Locale,
+			Text:        n.Text,
+			ContentType: n.ContentType,
+			Encoding:    n.Encoding,
+		})
+	}
+
+	return no
+}
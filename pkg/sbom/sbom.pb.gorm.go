@@ -116,10 +116,14 @@ type NodeORM struct {
 	Id                 string `gorm:"primaryKey"`
 	LicenseComments    string
 	LicenseConcluded   string
+	Manufacturers      []*PersonORM `gorm:"foreignKey:ManufacturersNodeId;references:Id"`
 	Name               string
 	NodeListId         *uint32
-	Originators        []*PersonORM `gorm:"foreignKey:OriginatorsNodeId;references:Id"`
+	Occurrences        []*OccurrenceORM `gorm:"foreignKey:NodeId;references:Id"`
+	Originators        []*PersonORM     `gorm:"foreignKey:OriginatorsNodeId;references:Id"`
+	Properties         []*PropertyORM   `gorm:"foreignKey:NodeId;references:Id"`
 	ReleaseDate        *time.Time
+	ReleaseNotes       *ReleaseNotesORM `gorm:"foreignKey:NodeId;references:Id"`
 	SourceInfo         string
 	Summary            string
 	Suppliers          []*PersonORM `gorm:"foreignKey:SuppliersNodeId;references:Id"`
@@ -210,6 +214,47 @@ func (m *Node) ToORM(ctx context.Context) (NodeORM, error) {
 	// Repeated type IdentifiersEntry is not an ORMable message type
 	// Repeated type HashesEntry is not an ORMable message type
 	// Repeated type enum is not an ORMable message type
+	// Repeated type string is not an ORMable message type
+	if m.ReleaseNotes != nil {
+		tempReleaseNotes, err := m.ReleaseNotes.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.ReleaseNotes = &tempReleaseNotes
+	}
+	for _, v := range m.Manufacturers {
+		if v != nil {
+			if tempManufacturers, cErr := v.ToORM(ctx); cErr == nil {
+				to.Manufacturers = append(to.Manufacturers, &tempManufacturers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Manufacturers = append(to.Manufacturers, nil)
+		}
+	}
+	for _, v := range m.Properties {
+		if v != nil {
+			if tempProperties, cErr := v.ToORM(ctx); cErr == nil {
+				to.Properties = append(to.Properties, &tempProperties)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Properties = append(to.Properties, nil)
+		}
+	}
+	for _, v := range m.Occurrences {
+		if v != nil {
+			if tempOccurrences, cErr := v.ToORM(ctx); cErr == nil {
+				to.Occurrences = append(to.Occurrences, &tempOccurrences)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Occurrences = append(to.Occurrences, nil)
+		}
+	}
 	if posthook, ok := interface{}(m).(NodeWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -288,6 +333,47 @@ func (m *NodeORM) ToPB(ctx context.Context) (Node, error) {
 	// Repeated type IdentifiersEntry is not an ORMable message type
 	// Repeated type HashesEntry is not an ORMable message type
 	// Repeated type enum is not an ORMable message type
+	// Repeated type string is not an ORMable message type
+	if m.ReleaseNotes != nil {
+		tempReleaseNotes, err := m.ReleaseNotes.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.ReleaseNotes = &tempReleaseNotes
+	}
+	for _, v := range m.Manufacturers {
+		if v != nil {
+			if tempManufacturers, cErr := v.ToPB(ctx); cErr == nil {
+				to.Manufacturers = append(to.Manufacturers, &tempManufacturers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Manufacturers = append(to.Manufacturers, nil)
+		}
+	}
+	for _, v := range m.Properties {
+		if v != nil {
+			if tempProperties, cErr := v.ToPB(ctx); cErr == nil {
+				to.Properties = append(to.Properties, &tempProperties)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Properties = append(to.Properties, nil)
+		}
+	}
+	for _, v := range m.Occurrences {
+		if v != nil {
+			if tempOccurrences, cErr := v.ToPB(ctx); cErr == nil {
+				to.Occurrences = append(to.Occurrences, &tempOccurrences)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Occurrences = append(to.Occurrences, nil)
+		}
+	}
 	if posthook, ok := interface{}(m).(NodeWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -324,7 +410,10 @@ type MetadataORM struct {
 	DocumentId    *uint32
 	DocumentTypes []*DocumentTypeORM `gorm:"foreignKey:MetadataId;references:Id"`
 	Id            string             `gorm:"primaryKey"`
+	Lifecycles    []*LifecycleORM    `gorm:"foreignKey:MetadataId;references:Id"`
 	Name          string
+	SourceFormat  string
+	SourceSha256  string
 	Tools         []*ToolORM `gorm:"foreignKey:MetadataId;references:Id"`
 	Version       string
 }
@@ -385,6 +474,19 @@ func (m *Metadata) ToORM(ctx context.Context) (MetadataORM, error) {
 			to.DocumentTypes = append(to.DocumentTypes, nil)
 		}
 	}
+	for _, v := range m.Lifecycles {
+		if v != nil {
+			if tempLifecycles, cErr := v.ToORM(ctx); cErr == nil {
+				to.Lifecycles = append(to.Lifecycles, &tempLifecycles)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Lifecycles = append(to.Lifecycles, nil)
+		}
+	}
+	to.SourceFormat = m.SourceFormat
+	to.SourceSha256 = m.SourceSha256
 	if posthook, ok := interface{}(m).(MetadataWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -441,6 +543,19 @@ func (m *MetadataORM) ToPB(ctx context.Context) (Metadata, error) {
 			to.DocumentTypes = append(to.DocumentTypes, nil)
 		}
 	}
+	for _, v := range m.Lifecycles {
+		if v != nil {
+			if tempLifecycles, cErr := v.ToPB(ctx); cErr == nil {
+				to.Lifecycles = append(to.Lifecycles, &tempLifecycles)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Lifecycles = append(to.Lifecycles, nil)
+		}
+	}
+	to.SourceFormat = m.SourceFormat
+	to.SourceSha256 = m.SourceSha256
 	if posthook, ok := interface{}(m).(MetadataWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -621,16 +736,17 @@ type ExternalReferenceWithAfterToPB interface {
 }
 
 type PersonORM struct {
-	Contacts          []*PersonORM `gorm:"foreignKey:Id;references:Id;many2many:person_contacts;joinForeignKey:PersonId;joinReferences:ContactId"`
-	Email             string       `gorm:"uniqueIndex:idx_person"`
-	Id                uint32       `gorm:"primaryKey;autoIncrement"`
-	IsOrg             bool         `gorm:"uniqueIndex:idx_person"`
-	MetadataId        *string
-	Name              string `gorm:"uniqueIndex:idx_person"`
-	OriginatorsNodeId *string
-	Phone             string `gorm:"uniqueIndex:idx_person"`
-	SuppliersNodeId   *string
-	Url               string `gorm:"uniqueIndex:idx_person"`
+	Contacts            []*PersonORM `gorm:"foreignKey:Id;references:Id;many2many:person_contacts;joinForeignKey:PersonId;joinReferences:ContactId"`
+	Email               string       `gorm:"uniqueIndex:idx_person"`
+	Id                  uint32       `gorm:"primaryKey;autoIncrement"`
+	IsOrg               bool         `gorm:"uniqueIndex:idx_person"`
+	ManufacturersNodeId *string
+	MetadataId          *string
+	Name                string `gorm:"uniqueIndex:idx_person"`
+	OriginatorsNodeId   *string
+	Phone               string `gorm:"uniqueIndex:idx_person"`
+	SuppliersNodeId     *string
+	Url                 string `gorm:"uniqueIndex:idx_person"`
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -751,6 +867,7 @@ func (m *Tool) ToORM(ctx context.Context) (ToolORM, error) {
 	to.Name = m.Name
 	to.Version = m.Version
 	to.Vendor = m.Vendor
+	// Repeated type HashesEntry is not an ORMable message type
 	if posthook, ok := interface{}(m).(ToolWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -770,6 +887,7 @@ func (m *ToolORM) ToPB(ctx context.Context) (Tool, error) {
 	to.Name = m.Name
 	to.Version = m.Version
 	to.Vendor = m.Vendor
+	// Repeated type HashesEntry is not an ORMable message type
 	if posthook, ok := interface{}(m).(ToolWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -870,52 +988,50 @@ type DocumentTypeWithAfterToPB interface {
 	AfterToPB(context.Context, *DocumentType) error
 }
 
-type NodeListORM struct {
-	DocumentId *uint32
-	Edges      []*EdgeORM `gorm:"foreignKey:NodeListId;references:Id"`
-	Id         uint32     `gorm:"primaryKey;autoIncrement"`
-	Nodes      []*NodeORM `gorm:"foreignKey:NodeListId;references:Id"`
+type ReleaseNotesORM struct {
+	Description string
+	Id          uint32 `gorm:"primaryKey;autoIncrement"`
+	NodeId      *string
+	Notes       []*ReleaseNoteORM `gorm:"foreignKey:ReleaseNotesId;references:Id"`
+	Timestamp   *time.Time
+	Title       string
+	Type        string
 }
 
 // TableName overrides the default tablename generated by GORM
-func (NodeListORM) TableName() string {
-	return "node_lists"
+func (ReleaseNotesORM) TableName() string {
+	return "release_notes"
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *NodeList) ToORM(ctx context.Context) (NodeListORM, error) {
-	to := NodeListORM{}
+func (m *ReleaseNotes) ToORM(ctx context.Context) (ReleaseNotesORM, error) {
+	to := ReleaseNotesORM{}
 	var err error
-	if prehook, ok := interface{}(m).(NodeListWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(ReleaseNotesWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	for _, v := range m.Nodes {
-		if v != nil {
-			if tempNodes, cErr := v.ToORM(ctx); cErr == nil {
-				to.Nodes = append(to.Nodes, &tempNodes)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.Nodes = append(to.Nodes, nil)
-		}
+	to.Type = m.Type
+	to.Title = m.Title
+	to.Description = m.Description
+	if m.Timestamp != nil {
+		t := m.Timestamp.AsTime()
+		to.Timestamp = &t
 	}
-	for _, v := range m.Edges {
+	for _, v := range m.Notes {
 		if v != nil {
-			if tempEdges, cErr := v.ToORM(ctx); cErr == nil {
-				to.Edges = append(to.Edges, &tempEdges)
+			if tempNotes, cErr := v.ToORM(ctx); cErr == nil {
+				to.Notes = append(to.Notes, &tempNotes)
 			} else {
 				return to, cErr
 			}
 		} else {
-			to.Edges = append(to.Edges, nil)
+			to.Notes = append(to.Notes, nil)
 		}
 	}
-	// Repeated type string is not an ORMable message type
-	if posthook, ok := interface{}(m).(NodeListWithAfterToORM); ok {
+	if posthook, ok := interface{}(m).(ReleaseNotesWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -923,177 +1039,2601 @@ func (m *NodeList) ToORM(ctx context.Context) (NodeListORM, error) {
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *NodeListORM) ToPB(ctx context.Context) (NodeList, error) {
-	to := NodeList{}
+func (m *ReleaseNotesORM) ToPB(ctx context.Context) (ReleaseNotes, error) {
+	to := ReleaseNotes{}
 	var err error
-	if prehook, ok := interface{}(m).(NodeListWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(ReleaseNotesWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	for _, v := range m.Nodes {
-		if v != nil {
-			if tempNodes, cErr := v.ToPB(ctx); cErr == nil {
-				to.Nodes = append(to.Nodes, &tempNodes)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.Nodes = append(to.Nodes, nil)
-		}
+	to.Type = m.Type
+	to.Title = m.Title
+	to.Description = m.Description
+	if m.Timestamp != nil {
+		to.Timestamp = timestamppb.New(*m.Timestamp)
 	}
-	for _, v := range m.Edges {
+	for _, v := range m.Notes {
 		if v != nil {
-			if tempEdges, cErr := v.ToPB(ctx); cErr == nil {
-				to.Edges = append(to.Edges, &tempEdges)
+			if tempNotes, cErr := v.ToPB(ctx); cErr == nil {
+				to.Notes = append(to.Notes, &tempNotes)
 			} else {
 				return to, cErr
 			}
 		} else {
-			to.Edges = append(to.Edges, nil)
+			to.Notes = append(to.Notes, nil)
 		}
 	}
-	// Repeated type string is not an ORMable message type
-	if posthook, ok := interface{}(m).(NodeListWithAfterToPB); ok {
+	if posthook, ok := interface{}(m).(ReleaseNotesWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type NodeList the arg will be the target, the caller the one being converted from
+// of type ReleaseNotes the arg will be the target, the caller the one being converted from
 
-// NodeListBeforeToORM called before default ToORM code
-type NodeListWithBeforeToORM interface {
-	BeforeToORM(context.Context, *NodeListORM) error
+// ReleaseNotesBeforeToORM called before default ToORM code
+type ReleaseNotesWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ReleaseNotesORM) error
 }
 
-// NodeListAfterToORM called after default ToORM code
-type NodeListWithAfterToORM interface {
-	AfterToORM(context.Context, *NodeListORM) error
+// ReleaseNotesAfterToORM called after default ToORM code
+type ReleaseNotesWithAfterToORM interface {
+	AfterToORM(context.Context, *ReleaseNotesORM) error
 }
 
-// NodeListBeforeToPB called before default ToPB code
-type NodeListWithBeforeToPB interface {
-	BeforeToPB(context.Context, *NodeList) error
+// ReleaseNotesBeforeToPB called before default ToPB code
+type ReleaseNotesWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ReleaseNotes) error
 }
 
-// NodeListAfterToPB called after default ToPB code
-type NodeListWithAfterToPB interface {
-	AfterToPB(context.Context, *NodeList) error
+// ReleaseNotesAfterToPB called after default ToPB code
+type ReleaseNotesWithAfterToPB interface {
+	AfterToPB(context.Context, *ReleaseNotes) error
 }
 
-// DefaultCreateDocument executes a basic gorm create call
-func DefaultCreateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+type ReleaseNoteORM struct {
+	ContentType    string
+	Encoding       string
+	Id             uint32 `gorm:"primaryKey;autoIncrement"`
+	Locale         string
+	ReleaseNotesId *uint32
+	Text           string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ReleaseNoteORM) TableName() string {
+	return "release_notes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ReleaseNote) ToORM(ctx context.Context) (ReleaseNoteORM, error) {
+	to := ReleaseNoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ReleaseNoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
+	to.Locale = m.Locale
+	to.Text = m.Text
+	to.ContentType = m.ContentType
+	to.Encoding = m.Encoding
+	if posthook, ok := interface{}(m).(ReleaseNoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ReleaseNoteORM) ToPB(ctx context.Context) (ReleaseNote, error) {
+	to := ReleaseNote{}
+	var err error
+	if prehook, ok := interface{}(m).(ReleaseNoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	to.Locale = m.Locale
+	to.Text = m.Text
+	to.ContentType = m.ContentType
+	to.Encoding = m.Encoding
+	if posthook, ok := interface{}(m).(ReleaseNoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type DocumentORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ReleaseNote the arg will be the target, the caller the one being converted from
+
+// ReleaseNoteBeforeToORM called before default ToORM code
+type ReleaseNoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ReleaseNoteORM) error
 }
-type DocumentORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// ReleaseNoteAfterToORM called after default ToORM code
+type ReleaseNoteWithAfterToORM interface {
+	AfterToORM(context.Context, *ReleaseNoteORM) error
 }
 
-func DefaultReadDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	ormResponse := DocumentORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormResponse).(DocumentORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+// ReleaseNoteBeforeToPB called before default ToPB code
+type ReleaseNoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ReleaseNote) error
 }
 
-type DocumentORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// ReleaseNoteAfterToPB called after default ToPB code
+type ReleaseNoteWithAfterToPB interface {
+	AfterToPB(context.Context, *ReleaseNote) error
 }
-type DocumentORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+type PropertyORM struct {
+	Id     uint32 `gorm:"primaryKey;autoIncrement"`
+	Name   string
+	NodeId *string
+	Value  string
 }
-type DocumentORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// TableName overrides the default tablename generated by GORM
+func (PropertyORM) TableName() string {
+	return "properties"
 }
 
-func DefaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Property) ToORM(ctx context.Context) (PropertyORM, error) {
+	to := PropertyORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PropertyWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
+	to.Name = m.Name
+	to.Value = m.Value
+	if posthook, ok := interface{}(m).(PropertyWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PropertyORM) ToPB(ctx context.Context) (Property, error) {
+	to := Property{}
+	var err error
+	if prehook, ok := interface{}(m).(PropertyWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&DocumentORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	to.Name = m.Name
+	to.Value = m.Value
+	if posthook, ok := interface{}(m).(PropertyWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	return err
+	return to, err
 }
 
-type DocumentORMWithBeforeDelete_ interface {
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Property the arg will be the target, the caller the one being converted from
+
+// PropertyBeforeToORM called before default ToORM code
+type PropertyWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PropertyORM) error
+}
+
+// PropertyAfterToORM called after default ToORM code
+type PropertyWithAfterToORM interface {
+	AfterToORM(context.Context, *PropertyORM) error
+}
+
+// PropertyBeforeToPB called before default ToPB code
+type PropertyWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Property) error
+}
+
+// PropertyAfterToPB called after default ToPB code
+type PropertyWithAfterToPB interface {
+	AfterToPB(context.Context, *Property) error
+}
+
+type OccurrenceORM struct {
+	AdditionalContext string
+	Id                uint32 `gorm:"primaryKey;autoIncrement"`
+	Line              int32
+	Location          string
+	NodeId            *string
+	Offset            int32
+	Symbol            string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OccurrenceORM) TableName() string {
+	return "occurrences"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Occurrence) ToORM(ctx context.Context) (OccurrenceORM, error) {
+	to := OccurrenceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OccurrenceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Location = m.Location
+	to.Line = m.Line
+	to.Offset = m.Offset
+	to.Symbol = m.Symbol
+	to.AdditionalContext = m.AdditionalContext
+	if posthook, ok := interface{}(m).(OccurrenceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OccurrenceORM) ToPB(ctx context.Context) (Occurrence, error) {
+	to := Occurrence{}
+	var err error
+	if prehook, ok := interface{}(m).(OccurrenceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Location = m.Location
+	to.Line = m.Line
+	to.Offset = m.Offset
+	to.Symbol = m.Symbol
+	to.AdditionalContext = m.AdditionalContext
+	if posthook, ok := interface{}(m).(OccurrenceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Occurrence the arg will be the target, the caller the one being converted from
+
+// OccurrenceBeforeToORM called before default ToORM code
+type OccurrenceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OccurrenceORM) error
+}
+
+// OccurrenceAfterToORM called after default ToORM code
+type OccurrenceWithAfterToORM interface {
+	AfterToORM(context.Context, *OccurrenceORM) error
+}
+
+// OccurrenceBeforeToPB called before default ToPB code
+type OccurrenceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Occurrence) error
+}
+
+// OccurrenceAfterToPB called after default ToPB code
+type OccurrenceWithAfterToPB interface {
+	AfterToPB(context.Context, *Occurrence) error
+}
+
+type LifecycleORM struct {
+	Description string
+	Id          uint32 `gorm:"primaryKey;autoIncrement"`
+	MetadataId  *string
+	Name        string
+	Phase       int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (LifecycleORM) TableName() string {
+	return "lifecycles"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Lifecycle) ToORM(ctx context.Context) (LifecycleORM, error) {
+	to := LifecycleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LifecycleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Phase = int32(m.Phase)
+	to.Name = m.Name
+	to.Description = m.Description
+	if posthook, ok := interface{}(m).(LifecycleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LifecycleORM) ToPB(ctx context.Context) (Lifecycle, error) {
+	to := Lifecycle{}
+	var err error
+	if prehook, ok := interface{}(m).(LifecycleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Phase = Lifecycle_Phase(m.Phase)
+	to.Name = m.Name
+	to.Description = m.Description
+	if posthook, ok := interface{}(m).(LifecycleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Lifecycle the arg will be the target, the caller the one being converted from
+
+// LifecycleBeforeToORM called before default ToORM code
+type LifecycleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LifecycleORM) error
+}
+
+// LifecycleAfterToORM called after default ToORM code
+type LifecycleWithAfterToORM interface {
+	AfterToORM(context.Context, *LifecycleORM) error
+}
+
+// LifecycleBeforeToPB called before default ToPB code
+type LifecycleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Lifecycle) error
+}
+
+// LifecycleAfterToPB called after default ToPB code
+type LifecycleWithAfterToPB interface {
+	AfterToPB(context.Context, *Lifecycle) error
+}
+
+type NodeListORM struct {
+	DocumentId *uint32
+	Edges      []*EdgeORM `gorm:"foreignKey:NodeListId;references:Id"`
+	Id         uint32     `gorm:"primaryKey;autoIncrement"`
+	Nodes      []*NodeORM `gorm:"foreignKey:NodeListId;references:Id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (NodeListORM) TableName() string {
+	return "node_lists"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *NodeList) ToORM(ctx context.Context) (NodeListORM, error) {
+	to := NodeListORM{}
+	var err error
+	if prehook, ok := interface{}(m).(NodeListWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	for _, v := range m.Nodes {
+		if v != nil {
+			if tempNodes, cErr := v.ToORM(ctx); cErr == nil {
+				to.Nodes = append(to.Nodes, &tempNodes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Nodes = append(to.Nodes, nil)
+		}
+	}
+	for _, v := range m.Edges {
+		if v != nil {
+			if tempEdges, cErr := v.ToORM(ctx); cErr == nil {
+				to.Edges = append(to.Edges, &tempEdges)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Edges = append(to.Edges, nil)
+		}
+	}
+	// Repeated type string is not an ORMable message type
+	if posthook, ok := interface{}(m).(NodeListWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *NodeListORM) ToPB(ctx context.Context) (NodeList, error) {
+	to := NodeList{}
+	var err error
+	if prehook, ok := interface{}(m).(NodeListWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	for _, v := range m.Nodes {
+		if v != nil {
+			if tempNodes, cErr := v.ToPB(ctx); cErr == nil {
+				to.Nodes = append(to.Nodes, &tempNodes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Nodes = append(to.Nodes, nil)
+		}
+	}
+	for _, v := range m.Edges {
+		if v != nil {
+			if tempEdges, cErr := v.ToPB(ctx); cErr == nil {
+				to.Edges = append(to.Edges, &tempEdges)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Edges = append(to.Edges, nil)
+		}
+	}
+	// Repeated type string is not an ORMable message type
+	if posthook, ok := interface{}(m).(NodeListWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type NodeList the arg will be the target, the caller the one being converted from
+
+// NodeListBeforeToORM called before default ToORM code
+type NodeListWithBeforeToORM interface {
+	BeforeToORM(context.Context, *NodeListORM) error
+}
+
+// NodeListAfterToORM called after default ToORM code
+type NodeListWithAfterToORM interface {
+	AfterToORM(context.Context, *NodeListORM) error
+}
+
+// NodeListBeforeToPB called before default ToPB code
+type NodeListWithBeforeToPB interface {
+	BeforeToPB(context.Context, *NodeList) error
+}
+
+// NodeListAfterToPB called after default ToPB code
+type NodeListWithAfterToPB interface {
+	AfterToPB(context.Context, *NodeList) error
+}
+
+// DefaultCreateDocument executes a basic gorm create call
+func DefaultCreateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := DocumentORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(DocumentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Document, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Document, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDocument clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDocument")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &DocumentORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterMetadata := MetadataORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterMetadata.DocumentId = new(uint32)
+	*filterMetadata.DocumentId = ormObj.Id
+	if err = db.Where(filterMetadata).Delete(MetadataORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterNodeList := NodeListORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterNodeList.DocumentId = new(uint32)
+	*filterNodeList.DocumentId = ormObj.Id
+	if err = db.Where(filterNodeList).Delete(NodeListORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDocument executes a basic gorm update call with patch behavior
+func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Document
+	var err error
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDocument(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDocument(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DocumentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDocument executes a bulk gorm update call with patch behavior
+func DefaultPatchSetDocument(ctx context.Context, objects []*Document, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Document, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Document, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchDocument(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskDocument patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patcher *Document, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Document, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedMetadata bool
+	var updatedNodeList bool
+	for i, f := range updateMask.Paths {
+		if !updatedMetadata && strings.HasPrefix(f, prefix+"Metadata.") {
+			updatedMetadata = true
+			if patcher.Metadata == nil {
+				patchee.Metadata = nil
+				continue
+			}
+			if patchee.Metadata == nil {
+				patchee.Metadata = &Metadata{}
+			}
+			if o, err := DefaultApplyFieldMaskMetadata(ctx, patchee.Metadata, patcher.Metadata, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Metadata.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Metadata = o
+			}
+			continue
+		}
+		if f == prefix+"Metadata" {
+			updatedMetadata = true
+			patchee.Metadata = patcher.Metadata
+			continue
+		}
+		if !updatedNodeList && strings.HasPrefix(f, prefix+"NodeList.") {
+			updatedNodeList = true
+			if patcher.NodeList == nil {
+				patchee.NodeList = nil
+				continue
+			}
+			if patchee.NodeList == nil {
+				patchee.NodeList = &NodeList{}
+			}
+			if o, err := DefaultApplyFieldMaskNodeList(ctx, patchee.NodeList, patcher.NodeList, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"NodeList.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.NodeList = o
+			}
+			continue
+		}
+		if f == prefix+"NodeList" {
+			updatedNodeList = true
+			patchee.NodeList = patcher.NodeList
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListDocument executes a gorm list call
+func DefaultListDocument(ctx context.Context, db *gorm.DB) ([]*Document, error) {
+	in := Document{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []DocumentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Document{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type DocumentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DocumentORM) error
+}
+
+// DefaultCreateNode executes a basic gorm create call
+func DefaultCreateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NodeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NodeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NodeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NodeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNode(ctx context.Context, in *Node, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&NodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type NodeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNodeSet(ctx context.Context, in []*Node, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&NodeORM{})).(NodeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&NodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NodeORM{})).(NodeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type NodeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Node, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Node, *gorm.DB) error
+}
+
+// DefaultStrictUpdateNode clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNode")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &NodeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterExternalReferences := ExternalReferenceORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterExternalReferences.NodeId = new(string)
+	*filterExternalReferences.NodeId = ormObj.Id
+	if err = db.Where(filterExternalReferences).Delete(ExternalReferenceORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterManufacturers := PersonORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterManufacturers.ManufacturersNodeId = new(string)
+	*filterManufacturers.ManufacturersNodeId = ormObj.Id
+	if err = db.Where(filterManufacturers).Delete(PersonORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterOccurrences := OccurrenceORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterOccurrences.NodeId = new(string)
+	*filterOccurrences.NodeId = ormObj.Id
+	if err = db.Where(filterOccurrences).Delete(OccurrenceORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterOriginators := PersonORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterOriginators.OriginatorsNodeId = new(string)
+	*filterOriginators.OriginatorsNodeId = ormObj.Id
+	if err = db.Where(filterOriginators).Delete(PersonORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterProperties := PropertyORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterProperties.NodeId = new(string)
+	*filterProperties.NodeId = ormObj.Id
+	if err = db.Where(filterProperties).Delete(PropertyORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterReleaseNotes := ReleaseNotesORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterReleaseNotes.NodeId = new(string)
+	*filterReleaseNotes.NodeId = ormObj.Id
+	if err = db.Where(filterReleaseNotes).Delete(ReleaseNotesORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterSuppliers := PersonORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterSuppliers.SuppliersNodeId = new(string)
+	*filterSuppliers.SuppliersNodeId = ormObj.Id
+	if err = db.Where(filterSuppliers).Delete(PersonORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NodeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchNode executes a basic gorm update call with patch behavior
+func DefaultPatchNode(ctx context.Context, in *Node, updateMask *field_mask.FieldMask, db *gorm.DB) (*Node, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Node
+	var err error
+	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNode(ctx, &Node{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNode(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNode(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NodeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NodeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NodeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NodeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NodeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetNode executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNode(ctx context.Context, objects []*Node, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Node, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Node, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNode(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskNode patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNode(ctx context.Context, patchee *Node, patcher *Node, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Node, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedReleaseDate bool
+	var updatedBuildDate bool
+	var updatedValidUntilDate bool
+	var updatedReleaseNotes bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"FileName" {
+			patchee.FileName = patcher.FileName
+			continue
+		}
+		if f == prefix+"UrlHome" {
+			patchee.UrlHome = patcher.UrlHome
+			continue
+		}
+		if f == prefix+"UrlDownload" {
+			patchee.UrlDownload = patcher.UrlDownload
+			continue
+		}
+		if f == prefix+"Licenses" {
+			patchee.Licenses = patcher.Licenses
+			continue
+		}
+		if f == prefix+"LicenseConcluded" {
+			patchee.LicenseConcluded = patcher.LicenseConcluded
+			continue
+		}
+		if f == prefix+"LicenseComments" {
+			patchee.LicenseComments = patcher.LicenseComments
+			continue
+		}
+		if f == prefix+"Copyright" {
+			patchee.Copyright = patcher.Copyright
+			continue
+		}
+		if f == prefix+"SourceInfo" {
+			patchee.SourceInfo = patcher.SourceInfo
+			continue
+		}
+		if f == prefix+"Comment" {
+			patchee.Comment = patcher.Comment
+			continue
+		}
+		if f == prefix+"Summary" {
+			patchee.Summary = patcher.Summary
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"Attribution" {
+			patchee.Attribution = patcher.Attribution
+			continue
+		}
+		if f == prefix+"Suppliers" {
+			patchee.Suppliers = patcher.Suppliers
+			continue
+		}
+		if f == prefix+"Originators" {
+			patchee.Originators = patcher.Originators
+			continue
+		}
+		if !updatedReleaseDate && strings.HasPrefix(f, prefix+"ReleaseDate.") {
+			if patcher.ReleaseDate == nil {
+				patchee.ReleaseDate = nil
+				continue
+			}
+			if patchee.ReleaseDate == nil {
+				patchee.ReleaseDate = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ReleaseDate."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ReleaseDate, patchee.ReleaseDate, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ReleaseDate" {
+			updatedReleaseDate = true
+			patchee.ReleaseDate = patcher.ReleaseDate
+			continue
+		}
+		if !updatedBuildDate && strings.HasPrefix(f, prefix+"BuildDate.") {
+			if patcher.BuildDate == nil {
+				patchee.BuildDate = nil
+				continue
+			}
+			if patchee.BuildDate == nil {
+				patchee.BuildDate = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"BuildDate."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.BuildDate, patchee.BuildDate, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"BuildDate" {
+			updatedBuildDate = true
+			patchee.BuildDate = patcher.BuildDate
+			continue
+		}
+		if !updatedValidUntilDate && strings.HasPrefix(f, prefix+"ValidUntilDate.") {
+			if patcher.ValidUntilDate == nil {
+				patchee.ValidUntilDate = nil
+				continue
+			}
+			if patchee.ValidUntilDate == nil {
+				patchee.ValidUntilDate = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidUntilDate."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ValidUntilDate, patchee.ValidUntilDate, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ValidUntilDate" {
+			updatedValidUntilDate = true
+			patchee.ValidUntilDate = patcher.ValidUntilDate
+			continue
+		}
+		if f == prefix+"ExternalReferences" {
+			patchee.ExternalReferences = patcher.ExternalReferences
+			continue
+		}
+		if f == prefix+"FileTypes" {
+			patchee.FileTypes = patcher.FileTypes
+			continue
+		}
+		if f == prefix+"Identifiers" {
+			patchee.Identifiers = patcher.Identifiers
+			continue
+		}
+		if f == prefix+"Hashes" {
+			patchee.Hashes = patcher.Hashes
+			continue
+		}
+		if f == prefix+"PrimaryPurpose" {
+			patchee.PrimaryPurpose = patcher.PrimaryPurpose
+			continue
+		}
+		if f == prefix+"AlternatePurls" {
+			patchee.AlternatePurls = patcher.AlternatePurls
+			continue
+		}
+		if !updatedReleaseNotes && strings.HasPrefix(f, prefix+"ReleaseNotes.") {
+			updatedReleaseNotes = true
+			if patcher.ReleaseNotes == nil {
+				patchee.ReleaseNotes = nil
+				continue
+			}
+			if patchee.ReleaseNotes == nil {
+				patchee.ReleaseNotes = &ReleaseNotes{}
+			}
+			if o, err := DefaultApplyFieldMaskReleaseNotes(ctx, patchee.ReleaseNotes, patcher.ReleaseNotes, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ReleaseNotes.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.ReleaseNotes = o
+			}
+			continue
+		}
+		if f == prefix+"ReleaseNotes" {
+			updatedReleaseNotes = true
+			patchee.ReleaseNotes = patcher.ReleaseNotes
+			continue
+		}
+		if f == prefix+"Manufacturers" {
+			patchee.Manufacturers = patcher.Manufacturers
+			continue
+		}
+		if f == prefix+"Properties" {
+			patchee.Properties = patcher.Properties
+			continue
+		}
+		if f == prefix+"Occurrences" {
+			patchee.Occurrences = patcher.Occurrences
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNode executes a gorm list call
+func DefaultListNode(ctx context.Context, db *gorm.DB) ([]*Node, error) {
+	in := Node{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []NodeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Node{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NodeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NodeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]NodeORM) error
+}
+
+// DefaultCreateMetadata executes a basic gorm create call
+func DefaultCreateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MetadataORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MetadataORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MetadataORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MetadataORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMetadata(ctx context.Context, in *Metadata, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MetadataORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MetadataORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMetadataSet(ctx context.Context, in []*Metadata, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MetadataORM{})).(MetadataORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&MetadataORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MetadataORM{})).(MetadataORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MetadataORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Metadata, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Metadata, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMetadata clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMetadata")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &MetadataORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterAuthors := PersonORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterAuthors.MetadataId = new(string)
+	*filterAuthors.MetadataId = ormObj.Id
+	if err = db.Where(filterAuthors).Delete(PersonORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterDocumentTypes := DocumentTypeORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterDocumentTypes.MetadataId = new(string)
+	*filterDocumentTypes.MetadataId = ormObj.Id
+	if err = db.Where(filterDocumentTypes).Delete(DocumentTypeORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterLifecycles := LifecycleORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterLifecycles.MetadataId = new(string)
+	*filterLifecycles.MetadataId = ormObj.Id
+	if err = db.Where(filterLifecycles).Delete(LifecycleORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterTools := ToolORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTools.MetadataId = new(string)
+	*filterTools.MetadataId = ormObj.Id
+	if err = db.Where(filterTools).Delete(ToolORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type MetadataORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMetadata executes a basic gorm update call with patch behavior
+func DefaultPatchMetadata(ctx context.Context, in *Metadata, updateMask *field_mask.FieldMask, db *gorm.DB) (*Metadata, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Metadata
+	var err error
+	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMetadata(ctx, &Metadata{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMetadata(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMetadata(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MetadataWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MetadataWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMetadata executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMetadata(ctx context.Context, objects []*Metadata, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Metadata, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Metadata, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMetadata(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMetadata patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMetadata(ctx context.Context, patchee *Metadata, patcher *Metadata, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Metadata, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedDate bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if !updatedDate && strings.HasPrefix(f, prefix+"Date.") {
+			if patcher.Date == nil {
+				patchee.Date = nil
+				continue
+			}
+			if patchee.Date == nil {
+				patchee.Date = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Date."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Date, patchee.Date, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Date" {
+			updatedDate = true
+			patchee.Date = patcher.Date
+			continue
+		}
+		if f == prefix+"Tools" {
+			patchee.Tools = patcher.Tools
+			continue
+		}
+		if f == prefix+"Authors" {
+			patchee.Authors = patcher.Authors
+			continue
+		}
+		if f == prefix+"Comment" {
+			patchee.Comment = patcher.Comment
+			continue
+		}
+		if f == prefix+"DocumentTypes" {
+			patchee.DocumentTypes = patcher.DocumentTypes
+			continue
+		}
+		if f == prefix+"Lifecycles" {
+			patchee.Lifecycles = patcher.Lifecycles
+			continue
+		}
+		if f == prefix+"SourceFormat" {
+			patchee.SourceFormat = patcher.SourceFormat
+			continue
+		}
+		if f == prefix+"Suppliers" {
+			patchee.Suppliers = patcher.Suppliers
+			continue
+		}
+		if f == prefix+"Manufacturers" {
+			patchee.Manufacturers = patcher.Manufacturers
+			continue
+		}
+		if f == prefix+"ExternalReferences" {
+			patchee.ExternalReferences = patcher.ExternalReferences
+			continue
+		}
+		if f == prefix+"SourceSha256" {
+			patchee.SourceSha256 = patcher.SourceSha256
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMetadata executes a gorm list call
+func DefaultListMetadata(ctx context.Context, db *gorm.DB) ([]*Metadata, error) {
+	in := Metadata{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []MetadataORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Metadata{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MetadataORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MetadataORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MetadataORM) error
+}
+
+// DefaultCreateEdge executes a basic gorm create call
+func DefaultCreateEdge(ctx context.Context, in *Edge, db *gorm.DB) (*Edge, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EdgeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EdgeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EdgeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskEdge patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEdge(ctx context.Context, patchee *Edge, patcher *Edge, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Edge, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
+			continue
+		}
+		if f == prefix+"From" {
+			patchee.From = patcher.From
+			continue
+		}
+		if f == prefix+"To" {
+			patchee.To = patcher.To
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListEdge executes a gorm list call
+func DefaultListEdge(ctx context.Context, db *gorm.DB) ([]*Edge, error) {
+	in := Edge{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []EdgeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EdgeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Edge{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type EdgeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EdgeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EdgeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EdgeORM) error
+}
+
+// DefaultCreateExternalReference executes a basic gorm create call
+func DefaultCreateExternalReference(ctx context.Context, in *ExternalReference, db *gorm.DB) (*ExternalReference, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExternalReferenceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalReferenceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskExternalReference patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskExternalReference(ctx context.Context, patchee *ExternalReference, patcher *ExternalReference, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ExternalReference, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Url" {
+			patchee.Url = patcher.Url
+			continue
+		}
+		if f == prefix+"Comment" {
+			patchee.Comment = patcher.Comment
+			continue
+		}
+		if f == prefix+"Authority" {
+			patchee.Authority = patcher.Authority
+			continue
+		}
+		if f == prefix+"Hashes" {
+			patchee.Hashes = patcher.Hashes
+			continue
+		}
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListExternalReference executes a gorm list call
+func DefaultListExternalReference(ctx context.Context, db *gorm.DB) ([]*ExternalReference, error) {
+	in := ExternalReference{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []ExternalReferenceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ExternalReference{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ExternalReferenceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalReferenceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalReferenceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ExternalReferenceORM) error
+}
+
+// DefaultCreatePerson executes a basic gorm create call
+func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PersonORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PersonORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePerson(ctx context.Context, in *Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PersonORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PersonORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePersonSet(ctx context.Context, in []*Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PersonORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PersonORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Person, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Person, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePerson clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePerson")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PersonORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("Contacts").Replace(ormObj.Contacts); err != nil {
+		return nil, err
+	}
+	ormObj.Contacts = nil
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPerson executes a basic gorm update call with patch behavior
+func DefaultPatchPerson(ctx context.Context, in *Person, updateMask *field_mask.FieldMask, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Person
+	var err error
+	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPerson(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePerson(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PersonWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PersonWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPerson executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPerson(ctx context.Context, objects []*Person, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Person, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Person, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPerson(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPerson patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPerson(ctx context.Context, patchee *Person, patcher *Person, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Person, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"IsOrg" {
+			patchee.IsOrg = patcher.IsOrg
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Url" {
+			patchee.Url = patcher.Url
+			continue
+		}
+		if f == prefix+"Phone" {
+			patchee.Phone = patcher.Phone
+			continue
+		}
+		if f == prefix+"Contacts" {
+			patchee.Contacts = patcher.Contacts
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPerson executes a gorm list call
+func DefaultListPerson(ctx context.Context, db *gorm.DB) ([]*Person, error) {
+	in := Person{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PersonORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Person{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PersonORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PersonORM) error
+}
+
+// DefaultCreateTool executes a basic gorm create call
+func DefaultCreateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ToolORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ToolORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ToolORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ToolORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ToolORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ToolORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ToolORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTool(ctx context.Context, in *Tool, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ToolORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ToolORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithAfterDelete_ interface {
+type ToolORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) error {
+func DefaultDeleteToolSet(ctx context.Context, in []*Tool, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1109,63 +3649,45 @@ func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB)
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ToolORM{})).(ToolORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&DocumentORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ToolORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ToolORM{})).(ToolORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type DocumentORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Document, *gorm.DB) (*gorm.DB, error)
+type ToolORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Tool, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Document, *gorm.DB) error
+type ToolORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Tool, *gorm.DB) error
 }
 
-// DefaultStrictUpdateDocument clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+// DefaultStrictUpdateTool clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDocument")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTool")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &DocumentORM{}
+	lockedRow := &ToolORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterMetadata := MetadataORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	filterMetadata.DocumentId = new(uint32)
-	*filterMetadata.DocumentId = ormObj.Id
-	if err = db.Where(filterMetadata).Delete(MetadataORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterNodeList := NodeListORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	filterNodeList.DocumentId = new(uint32)
-	*filterNodeList.DocumentId = ormObj.Id
-	if err = db.Where(filterNodeList).Delete(NodeListORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1173,7 +3695,7 @@ func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB)
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1185,46 +3707,46 @@ func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB)
 	return &pbResponse, err
 }
 
-type DocumentORMWithBeforeStrictUpdateCleanup interface {
+type ToolORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithBeforeStrictUpdateSave interface {
+type ToolORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithAfterStrictUpdateSave interface {
+type ToolORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchDocument executes a basic gorm update call with patch behavior
-func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
+// DefaultPatchTool executes a basic gorm update call with patch behavior
+func DefaultPatchTool(ctx context.Context, in *Tool, updateMask *field_mask.FieldMask, db *gorm.DB) (*Tool, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Document
+	var pbObj Tool
 	var err error
-	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskDocument(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTool(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateDocument(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTool(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ToolWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1232,89 +3754,175 @@ func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_m
 	return pbResponse, nil
 }
 
-type DocumentWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ToolWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ToolWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ToolWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) error
+type ToolWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetDocument executes a bulk gorm update call with patch behavior
-func DefaultPatchSetDocument(ctx context.Context, objects []*Document, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Document, error) {
+// DefaultPatchSetTool executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTool(ctx context.Context, objects []*Tool, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Tool, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Document, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchDocument(ctx, patcher, updateMasks[i], db)
-		if err != nil {
+
+	results := make([]*Tool, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTool(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTool patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTool(ctx context.Context, patchee *Tool, patcher *Tool, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Tool, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Vendor" {
+			patchee.Vendor = patcher.Vendor
+			continue
+		}
+		if f == prefix+"Hashes" {
+			patchee.Hashes = patcher.Hashes
+			continue
+		}
+		if f == prefix+"ExternalReferences" {
+			patchee.ExternalReferences = patcher.ExternalReferences
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTool executes a gorm list call
+func DefaultListTool(ctx context.Context, db *gorm.DB) ([]*Tool, error) {
+	in := Tool{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ToolORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Tool{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ToolORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ToolORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ToolORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ToolORM) error
+}
+
+// DefaultCreateDocumentType executes a basic gorm create call
+func DefaultCreateDocumentType(ctx context.Context, in *DocumentType, db *gorm.DB) (*DocumentType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
 	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
 
-	return results, nil
+type DocumentTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskDocument patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patcher *Document, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Document, error) {
+// DefaultApplyFieldMaskDocumentType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDocumentType(ctx context.Context, patchee *DocumentType, patcher *DocumentType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*DocumentType, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedMetadata bool
-	var updatedNodeList bool
-	for i, f := range updateMask.Paths {
-		if !updatedMetadata && strings.HasPrefix(f, prefix+"Metadata.") {
-			updatedMetadata = true
-			if patcher.Metadata == nil {
-				patchee.Metadata = nil
-				continue
-			}
-			if patchee.Metadata == nil {
-				patchee.Metadata = &Metadata{}
-			}
-			if o, err := DefaultApplyFieldMaskMetadata(ctx, patchee.Metadata, patcher.Metadata, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Metadata.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Metadata = o
-			}
-			continue
-		}
-		if f == prefix+"Metadata" {
-			updatedMetadata = true
-			patchee.Metadata = patcher.Metadata
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
 			continue
 		}
-		if !updatedNodeList && strings.HasPrefix(f, prefix+"NodeList.") {
-			updatedNodeList = true
-			if patcher.NodeList == nil {
-				patchee.NodeList = nil
-				continue
-			}
-			if patchee.NodeList == nil {
-				patchee.NodeList = &NodeList{}
-			}
-			if o, err := DefaultApplyFieldMaskNodeList(ctx, patchee.NodeList, patcher.NodeList, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"NodeList.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.NodeList = o
-			}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"NodeList" {
-			updatedNodeList = true
-			patchee.NodeList = patcher.NodeList
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
 			continue
 		}
 	}
@@ -1324,35 +3932,34 @@ func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patch
 	return patchee, nil
 }
 
-// DefaultListDocument executes a gorm list call
-func DefaultListDocument(ctx context.Context, db *gorm.DB) ([]*Document, error) {
-	in := Document{}
+// DefaultListDocumentType executes a gorm list call
+func DefaultListDocumentType(ctx context.Context, db *gorm.DB) ([]*DocumentType, error) {
+	in := DocumentType{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []DocumentORM{}
+	ormResponse := []DocumentTypeORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Document{}
+	pbResponse := []*DocumentType{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1363,18 +3970,18 @@ func DefaultListDocument(ctx context.Context, db *gorm.DB) ([]*Document, error)
 	return pbResponse, nil
 }
 
-type DocumentORMWithBeforeListApplyQuery interface {
+type DocumentTypeORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithBeforeListFind interface {
+type DocumentTypeORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]DocumentORM) error
+type DocumentTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DocumentTypeORM) error
 }
 
-// DefaultCreateNode executes a basic gorm create call
-func DefaultCreateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+// DefaultCreateReleaseNotes executes a basic gorm create call
+func DefaultCreateReleaseNotes(ctx context.Context, in *ReleaseNotes, db *gorm.DB) (*ReleaseNotes, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1382,7 +3989,7 @@ func DefaultCreateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1390,7 +3997,7 @@ func DefaultCreateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1399,14 +4006,14 @@ func DefaultCreateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error
 	return &pbResponse, err
 }
 
-type NodeORMWithBeforeCreate_ interface {
+type ReleaseNotesORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterCreate_ interface {
+type ReleaseNotesORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+func DefaultReadReleaseNotes(ctx context.Context, in *ReleaseNotes, db *gorm.DB) (*ReleaseNotes, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1414,24 +4021,24 @@ func DefaultReadNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error)
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := NodeORM{}
+	ormResponse := ReleaseNotesORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(NodeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ReleaseNotesORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1440,17 +4047,17 @@ func DefaultReadNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error)
 	return &pbResponse, err
 }
 
-type NodeORMWithBeforeReadApplyQuery interface {
+type ReleaseNotesORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithBeforeReadFind interface {
+type ReleaseNotesORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterReadFind interface {
+type ReleaseNotesORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteNode(ctx context.Context, in *Node, db *gorm.DB) error {
+func DefaultDeleteReleaseNotes(ctx context.Context, in *ReleaseNotes, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1458,113 +4065,95 @@ func DefaultDeleteNode(ctx context.Context, in *Node, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&NodeORM{}).Error
+	err = db.Where(&ormObj).Delete(&ReleaseNotesORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type NodeORMWithBeforeDelete_ interface {
+type ReleaseNotesORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterDelete_ interface {
+type ReleaseNotesORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteNodeSet(ctx context.Context, in []*Node, db *gorm.DB) error {
+func DefaultDeleteReleaseNotesSet(ctx context.Context, in []*ReleaseNotes, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint32{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&NodeORM{})).(NodeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ReleaseNotesORM{})).(ReleaseNotesORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&NodeORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ReleaseNotesORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&NodeORM{})).(NodeORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ReleaseNotesORM{})).(ReleaseNotesORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type NodeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Node, *gorm.DB) (*gorm.DB, error)
+type ReleaseNotesORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ReleaseNotes, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Node, *gorm.DB) error
+type ReleaseNotesORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ReleaseNotes, *gorm.DB) error
 }
 
-// DefaultStrictUpdateNode clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node, error) {
+// DefaultStrictUpdateReleaseNotes clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateReleaseNotes(ctx context.Context, in *ReleaseNotes, db *gorm.DB) (*ReleaseNotes, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNode")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateReleaseNotes")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &NodeORM{}
+	lockedRow := &ReleaseNotesORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterExternalReferences := ExternalReferenceORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterExternalReferences.NodeId = new(string)
-	*filterExternalReferences.NodeId = ormObj.Id
-	if err = db.Where(filterExternalReferences).Delete(ExternalReferenceORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterOriginators := PersonORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterOriginators.OriginatorsNodeId = new(string)
-	*filterOriginators.OriginatorsNodeId = ormObj.Id
-	if err = db.Where(filterOriginators).Delete(PersonORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterSuppliers := PersonORM{}
-	if ormObj.Id == "" {
+	filterNotes := ReleaseNoteORM{}
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	filterSuppliers.SuppliersNodeId = new(string)
-	*filterSuppliers.SuppliersNodeId = ormObj.Id
-	if err = db.Where(filterSuppliers).Delete(PersonORM{}).Error; err != nil {
+	filterNotes.ReleaseNotesId = new(uint32)
+	*filterNotes.ReleaseNotesId = ormObj.Id
+	if err = db.Where(filterNotes).Delete(ReleaseNoteORM{}).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1572,7 +4161,7 @@ func DefaultStrictUpdateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node,
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1584,51 +4173,46 @@ func DefaultStrictUpdateNode(ctx context.Context, in *Node, db *gorm.DB) (*Node,
 	return &pbResponse, err
 }
 
-type NodeORMWithBeforeStrictUpdateCleanup interface {
+type ReleaseNotesORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithBeforeStrictUpdateSave interface {
+type ReleaseNotesORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterStrictUpdateSave interface {
+type ReleaseNotesORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchNode executes a basic gorm update call with patch behavior
-func DefaultPatchNode(ctx context.Context, in *Node, updateMask *field_mask.FieldMask, db *gorm.DB) (*Node, error) {
+// DefaultPatchReleaseNotes executes a basic gorm update call with patch behavior
+func DefaultPatchReleaseNotes(ctx context.Context, in *ReleaseNotes, updateMask *field_mask.FieldMask, db *gorm.DB) (*ReleaseNotes, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Node
+	var pbObj ReleaseNotes
 	var err error
-	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNotesWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadNode(ctx, &Node{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNotesWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskNode(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskReleaseNotes(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(NodeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNotesWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateNode(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateReleaseNotes(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(NodeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ReleaseNotesWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1636,28 +4220,28 @@ func DefaultPatchNode(ctx context.Context, in *Node, updateMask *field_mask.Fiel
 	return pbResponse, nil
 }
 
-type NodeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNotesWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ReleaseNotes, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type NodeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNotesWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ReleaseNotes, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type NodeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNotesWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ReleaseNotes, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type NodeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Node, *field_mask.FieldMask, *gorm.DB) error
+type ReleaseNotesWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ReleaseNotes, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetNode executes a bulk gorm update call with patch behavior
-func DefaultPatchSetNode(ctx context.Context, objects []*Node, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Node, error) {
+// DefaultPatchSetReleaseNotes executes a bulk gorm update call with patch behavior
+func DefaultPatchSetReleaseNotes(ctx context.Context, objects []*ReleaseNotes, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ReleaseNotes, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Node, 0, len(objects))
+	results := make([]*ReleaseNotes, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchNode(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchReleaseNotes(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1668,177 +4252,53 @@ func DefaultPatchSetNode(ctx context.Context, objects []*Node, updateMasks []*fi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskNode patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskNode(ctx context.Context, patchee *Node, patcher *Node, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Node, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	var updatedReleaseDate bool
-	var updatedBuildDate bool
-	var updatedValidUntilDate bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Type" {
-			patchee.Type = patcher.Type
-			continue
-		}
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"Version" {
-			patchee.Version = patcher.Version
-			continue
-		}
-		if f == prefix+"FileName" {
-			patchee.FileName = patcher.FileName
-			continue
-		}
-		if f == prefix+"UrlHome" {
-			patchee.UrlHome = patcher.UrlHome
-			continue
-		}
-		if f == prefix+"UrlDownload" {
-			patchee.UrlDownload = patcher.UrlDownload
-			continue
-		}
-		if f == prefix+"Licenses" {
-			patchee.Licenses = patcher.Licenses
-			continue
-		}
-		if f == prefix+"LicenseConcluded" {
-			patchee.LicenseConcluded = patcher.LicenseConcluded
-			continue
-		}
-		if f == prefix+"LicenseComments" {
-			patchee.LicenseComments = patcher.LicenseComments
-			continue
-		}
-		if f == prefix+"Copyright" {
-			patchee.Copyright = patcher.Copyright
-			continue
-		}
-		if f == prefix+"SourceInfo" {
-			patchee.SourceInfo = patcher.SourceInfo
-			continue
-		}
-		if f == prefix+"Comment" {
-			patchee.Comment = patcher.Comment
+// DefaultApplyFieldMaskReleaseNotes patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskReleaseNotes(ctx context.Context, patchee *ReleaseNotes, patcher *ReleaseNotes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ReleaseNotes, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedTimestamp bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Type" {
+			patchee.Type = patcher.Type
 			continue
 		}
-		if f == prefix+"Summary" {
-			patchee.Summary = patcher.Summary
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
 			continue
 		}
 		if f == prefix+"Description" {
 			patchee.Description = patcher.Description
 			continue
 		}
-		if f == prefix+"Attribution" {
-			patchee.Attribution = patcher.Attribution
-			continue
-		}
-		if f == prefix+"Suppliers" {
-			patchee.Suppliers = patcher.Suppliers
-			continue
-		}
-		if f == prefix+"Originators" {
-			patchee.Originators = patcher.Originators
-			continue
-		}
-		if !updatedReleaseDate && strings.HasPrefix(f, prefix+"ReleaseDate.") {
-			if patcher.ReleaseDate == nil {
-				patchee.ReleaseDate = nil
-				continue
-			}
-			if patchee.ReleaseDate == nil {
-				patchee.ReleaseDate = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ReleaseDate."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.ReleaseDate, patchee.ReleaseDate, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"ReleaseDate" {
-			updatedReleaseDate = true
-			patchee.ReleaseDate = patcher.ReleaseDate
-			continue
-		}
-		if !updatedBuildDate && strings.HasPrefix(f, prefix+"BuildDate.") {
-			if patcher.BuildDate == nil {
-				patchee.BuildDate = nil
-				continue
-			}
-			if patchee.BuildDate == nil {
-				patchee.BuildDate = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"BuildDate."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.BuildDate, patchee.BuildDate, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"BuildDate" {
-			updatedBuildDate = true
-			patchee.BuildDate = patcher.BuildDate
-			continue
-		}
-		if !updatedValidUntilDate && strings.HasPrefix(f, prefix+"ValidUntilDate.") {
-			if patcher.ValidUntilDate == nil {
-				patchee.ValidUntilDate = nil
+		if !updatedTimestamp && strings.HasPrefix(f, prefix+"Timestamp.") {
+			if patcher.Timestamp == nil {
+				patchee.Timestamp = nil
 				continue
 			}
-			if patchee.ValidUntilDate == nil {
-				patchee.ValidUntilDate = &timestamppb.Timestamp{}
+			if patchee.Timestamp == nil {
+				patchee.Timestamp = &timestamppb.Timestamp{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ValidUntilDate."); trimPath != updateMask.Paths[j] {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Timestamp."); trimPath != updateMask.Paths[j] {
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.ValidUntilDate, patchee.ValidUntilDate, childMask); err != nil {
+			if err := gorm1.MergeWithMask(patcher.Timestamp, patchee.Timestamp, childMask); err != nil {
 				return nil, nil
 			}
 		}
-		if f == prefix+"ValidUntilDate" {
-			updatedValidUntilDate = true
-			patchee.ValidUntilDate = patcher.ValidUntilDate
-			continue
-		}
-		if f == prefix+"ExternalReferences" {
-			patchee.ExternalReferences = patcher.ExternalReferences
-			continue
-		}
-		if f == prefix+"FileTypes" {
-			patchee.FileTypes = patcher.FileTypes
-			continue
-		}
-		if f == prefix+"Identifiers" {
-			patchee.Identifiers = patcher.Identifiers
-			continue
-		}
-		if f == prefix+"Hashes" {
-			patchee.Hashes = patcher.Hashes
+		if f == prefix+"Timestamp" {
+			updatedTimestamp = true
+			patchee.Timestamp = patcher.Timestamp
 			continue
 		}
-		if f == prefix+"PrimaryPurpose" {
-			patchee.PrimaryPurpose = patcher.PrimaryPurpose
+		if f == prefix+"Notes" {
+			patchee.Notes = patcher.Notes
 			continue
 		}
 	}
@@ -1848,35 +4308,35 @@ func DefaultApplyFieldMaskNode(ctx context.Context, patchee *Node, patcher *Node
 	return patchee, nil
 }
 
-// DefaultListNode executes a gorm list call
-func DefaultListNode(ctx context.Context, db *gorm.DB) ([]*Node, error) {
-	in := Node{}
+// DefaultListReleaseNotes executes a gorm list call
+func DefaultListReleaseNotes(ctx context.Context, db *gorm.DB) ([]*ReleaseNotes, error) {
+	in := ReleaseNotes{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []NodeORM{}
+	ormResponse := []ReleaseNotesORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(NodeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNotesORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Node{}
+	pbResponse := []*ReleaseNotes{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1887,18 +4347,18 @@ func DefaultListNode(ctx context.Context, db *gorm.DB) ([]*Node, error) {
 	return pbResponse, nil
 }
 
-type NodeORMWithBeforeListApplyQuery interface {
+type ReleaseNotesORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithBeforeListFind interface {
+type ReleaseNotesORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type NodeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]NodeORM) error
+type ReleaseNotesORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ReleaseNotesORM) error
 }
 
-// DefaultCreateMetadata executes a basic gorm create call
-func DefaultCreateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+// DefaultCreateReleaseNote executes a basic gorm create call
+func DefaultCreateReleaseNote(ctx context.Context, in *ReleaseNote, db *gorm.DB) (*ReleaseNote, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1906,7 +4366,7 @@ func DefaultCreateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Met
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1914,7 +4374,7 @@ func DefaultCreateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Met
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1923,14 +4383,14 @@ func DefaultCreateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Met
 	return &pbResponse, err
 }
 
-type MetadataORMWithBeforeCreate_ interface {
+type ReleaseNoteORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterCreate_ interface {
+type ReleaseNoteORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+func DefaultReadReleaseNote(ctx context.Context, in *ReleaseNote, db *gorm.DB) (*ReleaseNote, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1938,24 +4398,24 @@ func DefaultReadMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metad
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := MetadataORM{}
+	ormResponse := ReleaseNoteORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(MetadataORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ReleaseNoteORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1964,17 +4424,17 @@ func DefaultReadMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metad
 	return &pbResponse, err
 }
 
-type MetadataORMWithBeforeReadApplyQuery interface {
+type ReleaseNoteORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithBeforeReadFind interface {
+type ReleaseNoteORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterReadFind interface {
+type ReleaseNoteORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMetadata(ctx context.Context, in *Metadata, db *gorm.DB) error {
+func DefaultDeleteReleaseNote(ctx context.Context, in *ReleaseNote, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1982,113 +4442,86 @@ func DefaultDeleteMetadata(ctx context.Context, in *Metadata, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&MetadataORM{}).Error
+	err = db.Where(&ormObj).Delete(&ReleaseNoteORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type MetadataORMWithBeforeDelete_ interface {
+type ReleaseNoteORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterDelete_ interface {
+type ReleaseNoteORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMetadataSet(ctx context.Context, in []*Metadata, db *gorm.DB) error {
+func DefaultDeleteReleaseNoteSet(ctx context.Context, in []*ReleaseNote, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint32{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&MetadataORM{})).(MetadataORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ReleaseNoteORM{})).(ReleaseNoteORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&MetadataORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ReleaseNoteORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&MetadataORM{})).(MetadataORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ReleaseNoteORM{})).(ReleaseNoteORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type MetadataORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Metadata, *gorm.DB) (*gorm.DB, error)
+type ReleaseNoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ReleaseNote, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Metadata, *gorm.DB) error
+type ReleaseNoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ReleaseNote, *gorm.DB) error
 }
 
-// DefaultStrictUpdateMetadata clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateMetadata(ctx context.Context, in *Metadata, db *gorm.DB) (*Metadata, error) {
+// DefaultStrictUpdateReleaseNote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateReleaseNote(ctx context.Context, in *ReleaseNote, db *gorm.DB) (*ReleaseNote, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMetadata")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateReleaseNote")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &MetadataORM{}
+	lockedRow := &ReleaseNoteORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterAuthors := PersonORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterAuthors.MetadataId = new(string)
-	*filterAuthors.MetadataId = ormObj.Id
-	if err = db.Where(filterAuthors).Delete(PersonORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterDocumentTypes := DocumentTypeORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterDocumentTypes.MetadataId = new(string)
-	*filterDocumentTypes.MetadataId = ormObj.Id
-	if err = db.Where(filterDocumentTypes).Delete(DocumentTypeORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterTools := ToolORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterTools.MetadataId = new(string)
-	*filterTools.MetadataId = ormObj.Id
-	if err = db.Where(filterTools).Delete(ToolORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2096,7 +4529,7 @@ func DefaultStrictUpdateMetadata(ctx context.Context, in *Metadata, db *gorm.DB)
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2108,51 +4541,46 @@ func DefaultStrictUpdateMetadata(ctx context.Context, in *Metadata, db *gorm.DB)
 	return &pbResponse, err
 }
 
-type MetadataORMWithBeforeStrictUpdateCleanup interface {
+type ReleaseNoteORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithBeforeStrictUpdateSave interface {
+type ReleaseNoteORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterStrictUpdateSave interface {
+type ReleaseNoteORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchMetadata executes a basic gorm update call with patch behavior
-func DefaultPatchMetadata(ctx context.Context, in *Metadata, updateMask *field_mask.FieldMask, db *gorm.DB) (*Metadata, error) {
+// DefaultPatchReleaseNote executes a basic gorm update call with patch behavior
+func DefaultPatchReleaseNote(ctx context.Context, in *ReleaseNote, updateMask *field_mask.FieldMask, db *gorm.DB) (*ReleaseNote, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Metadata
+	var pbObj ReleaseNote
 	var err error
-	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNoteWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadMetadata(ctx, &Metadata{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNoteWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskMetadata(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskReleaseNote(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(MetadataWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ReleaseNoteWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateMetadata(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateReleaseNote(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(MetadataWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ReleaseNoteWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2160,28 +4588,28 @@ func DefaultPatchMetadata(ctx context.Context, in *Metadata, updateMask *field_m
 	return pbResponse, nil
 }
 
-type MetadataWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ReleaseNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ReleaseNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ReleaseNoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ReleaseNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Metadata, *field_mask.FieldMask, *gorm.DB) error
+type ReleaseNoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ReleaseNote, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetMetadata executes a bulk gorm update call with patch behavior
-func DefaultPatchSetMetadata(ctx context.Context, objects []*Metadata, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Metadata, error) {
+// DefaultPatchSetReleaseNote executes a bulk gorm update call with patch behavior
+func DefaultPatchSetReleaseNote(ctx context.Context, objects []*ReleaseNote, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ReleaseNote, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Metadata, 0, len(objects))
+	results := make([]*ReleaseNote, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchMetadata(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchReleaseNote(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2192,65 +4620,29 @@ func DefaultPatchSetMetadata(ctx context.Context, objects []*Metadata, updateMas
 	return results, nil
 }
 
-// DefaultApplyFieldMaskMetadata patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMetadata(ctx context.Context, patchee *Metadata, patcher *Metadata, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Metadata, error) {
+// DefaultApplyFieldMaskReleaseNote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskReleaseNote(ctx context.Context, patchee *ReleaseNote, patcher *ReleaseNote, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ReleaseNote, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedDate bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Version" {
-			patchee.Version = patcher.Version
-			continue
-		}
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if !updatedDate && strings.HasPrefix(f, prefix+"Date.") {
-			if patcher.Date == nil {
-				patchee.Date = nil
-				continue
-			}
-			if patchee.Date == nil {
-				patchee.Date = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Date."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.Date, patchee.Date, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"Date" {
-			updatedDate = true
-			patchee.Date = patcher.Date
-			continue
-		}
-		if f == prefix+"Tools" {
-			patchee.Tools = patcher.Tools
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Locale" {
+			patchee.Locale = patcher.Locale
 			continue
 		}
-		if f == prefix+"Authors" {
-			patchee.Authors = patcher.Authors
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
 			continue
 		}
-		if f == prefix+"Comment" {
-			patchee.Comment = patcher.Comment
+		if f == prefix+"ContentType" {
+			patchee.ContentType = patcher.ContentType
 			continue
 		}
-		if f == prefix+"DocumentTypes" {
-			patchee.DocumentTypes = patcher.DocumentTypes
+		if f == prefix+"Encoding" {
+			patchee.Encoding = patcher.Encoding
 			continue
 		}
 	}
@@ -2260,35 +4652,35 @@ func DefaultApplyFieldMaskMetadata(ctx context.Context, patchee *Metadata, patch
 	return patchee, nil
 }
 
-// DefaultListMetadata executes a gorm list call
-func DefaultListMetadata(ctx context.Context, db *gorm.DB) ([]*Metadata, error) {
-	in := Metadata{}
+// DefaultListReleaseNote executes a gorm list call
+func DefaultListReleaseNote(ctx context.Context, db *gorm.DB) ([]*ReleaseNote, error) {
+	in := ReleaseNote{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []MetadataORM{}
+	ormResponse := []ReleaseNoteORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MetadataORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ReleaseNoteORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Metadata{}
+	pbResponse := []*ReleaseNote{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2299,18 +4691,18 @@ func DefaultListMetadata(ctx context.Context, db *gorm.DB) ([]*Metadata, error)
 	return pbResponse, nil
 }
 
-type MetadataORMWithBeforeListApplyQuery interface {
+type ReleaseNoteORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithBeforeListFind interface {
+type ReleaseNoteORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MetadataORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MetadataORM) error
+type ReleaseNoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ReleaseNoteORM) error
 }
 
-// DefaultCreateEdge executes a basic gorm create call
-func DefaultCreateEdge(ctx context.Context, in *Edge, db *gorm.DB) (*Edge, error) {
+// DefaultCreateProperty executes a basic gorm create call
+func DefaultCreateProperty(ctx context.Context, in *Property, db *gorm.DB) (*Property, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2318,7 +4710,7 @@ func DefaultCreateEdge(ctx context.Context, in *Edge, db *gorm.DB) (*Edge, error
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2326,7 +4718,7 @@ func DefaultCreateEdge(ctx context.Context, in *Edge, db *gorm.DB) (*Edge, error
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EdgeORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2335,124 +4727,245 @@ func DefaultCreateEdge(ctx context.Context, in *Edge, db *gorm.DB) (*Edge, error
 	return &pbResponse, err
 }
 
-type EdgeORMWithBeforeCreate_ interface {
+type PropertyORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EdgeORMWithAfterCreate_ interface {
+type PropertyORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskEdge patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskEdge(ctx context.Context, patchee *Edge, patcher *Edge, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Edge, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+func DefaultReadProperty(ctx context.Context, in *Property, db *gorm.DB) (*Property, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PropertyORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PropertyORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PropertyORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProperty(ctx context.Context, in *Property, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PropertyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PropertyORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePropertySet(ctx context.Context, in []*Property, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Type" {
-			patchee.Type = patcher.Type
-			continue
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"From" {
-			patchee.From = patcher.From
-			continue
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
-		if f == prefix+"To" {
-			patchee.To = patcher.To
-			continue
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PropertyORM{})).(PropertyORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
 		}
 	}
+	err = db.Where("id in (?)", keys).Delete(&PropertyORM{}).Error
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return patchee, nil
+	if hook, ok := (interface{}(&PropertyORM{})).(PropertyORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
 }
 
-// DefaultListEdge executes a gorm list call
-func DefaultListEdge(ctx context.Context, db *gorm.DB) ([]*Edge, error) {
-	in := Edge{}
+type PropertyORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Property, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Property, *gorm.DB) error
+}
+
+// DefaultStrictUpdateProperty clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProperty(ctx context.Context, in *Property, db *gorm.DB) (*Property, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateProperty")
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	lockedRow := &PropertyORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(EdgeORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []EdgeORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EdgeORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Edge{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
+	return &pbResponse, err
 }
 
-type EdgeORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type PropertyORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EdgeORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type PropertyORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EdgeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]EdgeORM) error
+type PropertyORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultCreateExternalReference executes a basic gorm create call
-func DefaultCreateExternalReference(ctx context.Context, in *ExternalReference, db *gorm.DB) (*ExternalReference, error) {
+// DefaultPatchProperty executes a basic gorm update call with patch behavior
+func DefaultPatchProperty(ctx context.Context, in *Property, updateMask *field_mask.FieldMask, db *gorm.DB) (*Property, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	var pbObj Property
+	var err error
+	if hook, ok := interface{}(&pbObj).(PropertyWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(PropertyWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskProperty(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&pbObj).(PropertyWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
+	pbResponse, err := DefaultStrictUpdateProperty(ctx, &pbObj, db)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(pbResponse).(PropertyWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type ExternalReferenceORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type PropertyWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Property, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ExternalReferenceORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type PropertyWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Property, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Property, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PropertyWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Property, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskExternalReference patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskExternalReference(ctx context.Context, patchee *ExternalReference, patcher *ExternalReference, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ExternalReference, error) {
+// DefaultPatchSetProperty executes a bulk gorm update call with patch behavior
+func DefaultPatchSetProperty(ctx context.Context, objects []*Property, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Property, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Property, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchProperty(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskProperty patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskProperty(ctx context.Context, patchee *Property, patcher *Property, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Property, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2460,24 +4973,12 @@ func DefaultApplyFieldMaskExternalReference(ctx context.Context, patchee *Extern
 	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Url" {
-			patchee.Url = patcher.Url
-			continue
-		}
-		if f == prefix+"Comment" {
-			patchee.Comment = patcher.Comment
-			continue
-		}
-		if f == prefix+"Authority" {
-			patchee.Authority = patcher.Authority
-			continue
-		}
-		if f == prefix+"Hashes" {
-			patchee.Hashes = patcher.Hashes
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"Type" {
-			patchee.Type = patcher.Type
+		if f == prefix+"Value" {
+			patchee.Value = patcher.Value
 			continue
 		}
 	}
@@ -2487,34 +4988,35 @@ func DefaultApplyFieldMaskExternalReference(ctx context.Context, patchee *Extern
 	return patchee, nil
 }
 
-// DefaultListExternalReference executes a gorm list call
-func DefaultListExternalReference(ctx context.Context, db *gorm.DB) ([]*ExternalReference, error) {
-	in := ExternalReference{}
+// DefaultListProperty executes a gorm list call
+func DefaultListProperty(ctx context.Context, db *gorm.DB) ([]*Property, error) {
+	in := Property{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	ormResponse := []ExternalReferenceORM{}
+	db = db.Order("id")
+	ormResponse := []PropertyORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ExternalReferenceORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PropertyORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*ExternalReference{}
+	pbResponse := []*Property{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2525,18 +5027,18 @@ func DefaultListExternalReference(ctx context.Context, db *gorm.DB) ([]*External
 	return pbResponse, nil
 }
 
-type ExternalReferenceORMWithBeforeListApplyQuery interface {
+type PropertyORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ExternalReferenceORMWithBeforeListFind interface {
+type PropertyORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ExternalReferenceORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]ExternalReferenceORM) error
+type PropertyORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PropertyORM) error
 }
 
-// DefaultCreatePerson executes a basic gorm create call
-func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+// DefaultCreateOccurrence executes a basic gorm create call
+func DefaultCreateOccurrence(ctx context.Context, in *Occurrence, db *gorm.DB) (*Occurrence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2544,7 +5046,7 @@ func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person,
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2552,7 +5054,7 @@ func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person,
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2561,14 +5063,14 @@ func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person,
 	return &pbResponse, err
 }
 
-type PersonORMWithBeforeCreate_ interface {
+type OccurrenceORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterCreate_ interface {
+type OccurrenceORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadPerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+func DefaultReadOccurrence(ctx context.Context, in *Occurrence, db *gorm.DB) (*Occurrence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2579,21 +5081,21 @@ func DefaultReadPerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, e
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PersonORM{}
+	ormResponse := OccurrenceORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PersonORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(OccurrenceORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2602,17 +5104,17 @@ func DefaultReadPerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, e
 	return &pbResponse, err
 }
 
-type PersonORMWithBeforeReadApplyQuery interface {
+type OccurrenceORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithBeforeReadFind interface {
+type OccurrenceORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterReadFind interface {
+type OccurrenceORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePerson(ctx context.Context, in *Person, db *gorm.DB) error {
+func DefaultDeleteOccurrence(ctx context.Context, in *Occurrence, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2623,29 +5125,29 @@ func DefaultDeletePerson(ctx context.Context, in *Person, db *gorm.DB) error {
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PersonORM{}).Error
+	err = db.Where(&ormObj).Delete(&OccurrenceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PersonORMWithBeforeDelete_ interface {
+type OccurrenceORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterDelete_ interface {
+type OccurrenceORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePersonSet(ctx context.Context, in []*Person, db *gorm.DB) error {
+func DefaultDeleteOccurrenceSet(ctx context.Context, in []*Occurrence, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2661,49 +5163,45 @@ func DefaultDeletePersonSet(ctx context.Context, in []*Person, db *gorm.DB) erro
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&OccurrenceORM{})).(OccurrenceORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PersonORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&OccurrenceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&OccurrenceORM{})).(OccurrenceORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PersonORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Person, *gorm.DB) (*gorm.DB, error)
+type OccurrenceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Occurrence, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Person, *gorm.DB) error
+type OccurrenceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Occurrence, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePerson clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+// DefaultStrictUpdateOccurrence clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOccurrence(ctx context.Context, in *Occurrence, db *gorm.DB) (*Occurrence, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePerson")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOccurrence")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &PersonORM{}
+	lockedRow := &OccurrenceORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("Contacts").Replace(ormObj.Contacts); err != nil {
-		return nil, err
-	}
-	ormObj.Contacts = nil
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2711,7 +5209,7 @@ func DefaultStrictUpdatePerson(ctx context.Context, in *Person, db *gorm.DB) (*P
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2723,46 +5221,46 @@ func DefaultStrictUpdatePerson(ctx context.Context, in *Person, db *gorm.DB) (*P
 	return &pbResponse, err
 }
 
-type PersonORMWithBeforeStrictUpdateCleanup interface {
+type OccurrenceORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithBeforeStrictUpdateSave interface {
+type OccurrenceORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterStrictUpdateSave interface {
+type OccurrenceORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPerson executes a basic gorm update call with patch behavior
-func DefaultPatchPerson(ctx context.Context, in *Person, updateMask *field_mask.FieldMask, db *gorm.DB) (*Person, error) {
+// DefaultPatchOccurrence executes a basic gorm update call with patch behavior
+func DefaultPatchOccurrence(ctx context.Context, in *Occurrence, updateMask *field_mask.FieldMask, db *gorm.DB) (*Occurrence, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Person
+	var pbObj Occurrence
 	var err error
-	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(OccurrenceWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(OccurrenceWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPerson(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskOccurrence(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PersonWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(OccurrenceWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePerson(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateOccurrence(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PersonWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(OccurrenceWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2770,28 +5268,28 @@ func DefaultPatchPerson(ctx context.Context, in *Person, updateMask *field_mask.
 	return pbResponse, nil
 }
 
-type PersonWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OccurrenceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Occurrence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PersonWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OccurrenceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Occurrence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PersonWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type OccurrenceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Occurrence, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PersonWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) error
+type OccurrenceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Occurrence, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPerson executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPerson(ctx context.Context, objects []*Person, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Person, error) {
+// DefaultPatchSetOccurrence executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOccurrence(ctx context.Context, objects []*Occurrence, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Occurrence, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Person, 0, len(objects))
+	results := make([]*Occurrence, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPerson(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchOccurrence(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2802,8 +5300,8 @@ func DefaultPatchSetPerson(ctx context.Context, objects []*Person, updateMasks [
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPerson patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPerson(ctx context.Context, patchee *Person, patcher *Person, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Person, error) {
+// DefaultApplyFieldMaskOccurrence patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOccurrence(ctx context.Context, patchee *Occurrence, patcher *Occurrence, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Occurrence, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2811,28 +5309,24 @@ func DefaultApplyFieldMaskPerson(ctx context.Context, patchee *Person, patcher *
 	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"IsOrg" {
-			patchee.IsOrg = patcher.IsOrg
+		if f == prefix+"Location" {
+			patchee.Location = patcher.Location
 			continue
 		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
+		if f == prefix+"Line" {
+			patchee.Line = patcher.Line
 			continue
 		}
-		if f == prefix+"Url" {
-			patchee.Url = patcher.Url
+		if f == prefix+"Offset" {
+			patchee.Offset = patcher.Offset
 			continue
 		}
-		if f == prefix+"Phone" {
-			patchee.Phone = patcher.Phone
+		if f == prefix+"Symbol" {
+			patchee.Symbol = patcher.Symbol
 			continue
 		}
-		if f == prefix+"Contacts" {
-			patchee.Contacts = patcher.Contacts
+		if f == prefix+"AdditionalContext" {
+			patchee.AdditionalContext = patcher.AdditionalContext
 			continue
 		}
 	}
@@ -2842,35 +5336,35 @@ func DefaultApplyFieldMaskPerson(ctx context.Context, patchee *Person, patcher *
 	return patchee, nil
 }
 
-// DefaultListPerson executes a gorm list call
-func DefaultListPerson(ctx context.Context, db *gorm.DB) ([]*Person, error) {
-	in := Person{}
+// DefaultListOccurrence executes a gorm list call
+func DefaultListOccurrence(ctx context.Context, db *gorm.DB) ([]*Occurrence, error) {
+	in := Occurrence{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PersonORM{}
+	ormResponse := []OccurrenceORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(OccurrenceORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Person{}
+	pbResponse := []*Occurrence{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2881,18 +5375,18 @@ func DefaultListPerson(ctx context.Context, db *gorm.DB) ([]*Person, error) {
 	return pbResponse, nil
 }
 
-type PersonORMWithBeforeListApplyQuery interface {
+type OccurrenceORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithBeforeListFind interface {
+type OccurrenceORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PersonORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PersonORM) error
+type OccurrenceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OccurrenceORM) error
 }
 
-// DefaultCreateTool executes a basic gorm create call
-func DefaultCreateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
+// DefaultCreateLifecycle executes a basic gorm create call
+func DefaultCreateLifecycle(ctx context.Context, in *Lifecycle, db *gorm.DB) (*Lifecycle, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2900,7 +5394,7 @@ func DefaultCreateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2908,7 +5402,7 @@ func DefaultCreateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2917,14 +5411,14 @@ func DefaultCreateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error
 	return &pbResponse, err
 }
 
-type ToolORMWithBeforeCreate_ interface {
+type LifecycleORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithAfterCreate_ interface {
+type LifecycleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
+func DefaultReadLifecycle(ctx context.Context, in *Lifecycle, db *gorm.DB) (*Lifecycle, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2935,21 +5429,21 @@ func DefaultReadTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error)
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := ToolORM{}
+	ormResponse := LifecycleORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(ToolORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(LifecycleORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2958,17 +5452,17 @@ func DefaultReadTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error)
 	return &pbResponse, err
 }
 
-type ToolORMWithBeforeReadApplyQuery interface {
+type LifecycleORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithBeforeReadFind interface {
+type LifecycleORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithAfterReadFind interface {
+type LifecycleORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTool(ctx context.Context, in *Tool, db *gorm.DB) error {
+func DefaultDeleteLifecycle(ctx context.Context, in *Lifecycle, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2979,29 +5473,29 @@ func DefaultDeleteTool(ctx context.Context, in *Tool, db *gorm.DB) error {
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&ToolORM{}).Error
+	err = db.Where(&ormObj).Delete(&LifecycleORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type ToolORMWithBeforeDelete_ interface {
+type LifecycleORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithAfterDelete_ interface {
+type LifecycleORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteToolSet(ctx context.Context, in []*Tool, db *gorm.DB) error {
+func DefaultDeleteLifecycleSet(ctx context.Context, in []*Lifecycle, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3017,45 +5511,45 @@ func DefaultDeleteToolSet(ctx context.Context, in []*Tool, db *gorm.DB) error {
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&ToolORM{})).(ToolORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&LifecycleORM{})).(LifecycleORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&ToolORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&LifecycleORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&ToolORM{})).(ToolORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&LifecycleORM{})).(LifecycleORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type ToolORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Tool, *gorm.DB) (*gorm.DB, error)
+type LifecycleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Lifecycle, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Tool, *gorm.DB) error
+type LifecycleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Lifecycle, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTool clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool, error) {
+// DefaultStrictUpdateLifecycle clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLifecycle(ctx context.Context, in *Lifecycle, db *gorm.DB) (*Lifecycle, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTool")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLifecycle")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &ToolORM{}
+	lockedRow := &LifecycleORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3063,7 +5557,7 @@ func DefaultStrictUpdateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool,
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3075,46 +5569,46 @@ func DefaultStrictUpdateTool(ctx context.Context, in *Tool, db *gorm.DB) (*Tool,
 	return &pbResponse, err
 }
 
-type ToolORMWithBeforeStrictUpdateCleanup interface {
+type LifecycleORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithBeforeStrictUpdateSave interface {
+type LifecycleORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type ToolORMWithAfterStrictUpdateSave interface {
+type LifecycleORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTool executes a basic gorm update call with patch behavior
-func DefaultPatchTool(ctx context.Context, in *Tool, updateMask *field_mask.FieldMask, db *gorm.DB) (*Tool, error) {
+// DefaultPatchLifecycle executes a basic gorm update call with patch behavior
+func DefaultPatchLifecycle(ctx context.Context, in *Lifecycle, updateMask *field_mask.FieldMask, db *gorm.DB) (*Lifecycle, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Tool
+	var pbObj Lifecycle
 	var err error
-	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(LifecycleWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(LifecycleWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTool(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskLifecycle(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(ToolWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(LifecycleWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTool(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateLifecycle(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(ToolWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(LifecycleWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3122,28 +5616,28 @@ func DefaultPatchTool(ctx context.Context, in *Tool, updateMask *field_mask.Fiel
 	return pbResponse, nil
 }
 
-type ToolWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type LifecycleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Lifecycle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ToolWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type LifecycleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Lifecycle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ToolWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type LifecycleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Lifecycle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type ToolWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Tool, *field_mask.FieldMask, *gorm.DB) error
+type LifecycleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Lifecycle, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTool executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTool(ctx context.Context, objects []*Tool, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Tool, error) {
+// DefaultPatchSetLifecycle executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLifecycle(ctx context.Context, objects []*Lifecycle, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Lifecycle, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Tool, 0, len(objects))
+	results := make([]*Lifecycle, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTool(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchLifecycle(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -3154,118 +5648,8 @@ func DefaultPatchSetTool(ctx context.Context, objects []*Tool, updateMasks []*fi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTool patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTool(ctx context.Context, patchee *Tool, patcher *Tool, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Tool, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"Version" {
-			patchee.Version = patcher.Version
-			continue
-		}
-		if f == prefix+"Vendor" {
-			patchee.Vendor = patcher.Vendor
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListTool executes a gorm list call
-func DefaultListTool(ctx context.Context, db *gorm.DB) ([]*Tool, error) {
-	in := Tool{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []ToolORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(ToolORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*Tool{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
-}
-
-type ToolORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type ToolORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type ToolORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]ToolORM) error
-}
-
-// DefaultCreateDocumentType executes a basic gorm create call
-func DefaultCreateDocumentType(ctx context.Context, in *DocumentType, db *gorm.DB) (*DocumentType, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type DocumentTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type DocumentTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
-}
-
-// DefaultApplyFieldMaskDocumentType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskDocumentType(ctx context.Context, patchee *DocumentType, patcher *DocumentType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*DocumentType, error) {
+// DefaultApplyFieldMaskLifecycle patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLifecycle(ctx context.Context, patchee *Lifecycle, patcher *Lifecycle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Lifecycle, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -3273,8 +5657,8 @@ func DefaultApplyFieldMaskDocumentType(ctx context.Context, patchee *DocumentTyp
 	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Type" {
-			patchee.Type = patcher.Type
+		if f == prefix+"Phase" {
+			patchee.Phase = patcher.Phase
 			continue
 		}
 		if f == prefix+"Name" {
@@ -3292,34 +5676,35 @@ func DefaultApplyFieldMaskDocumentType(ctx context.Context, patchee *DocumentTyp
 	return patchee, nil
 }
 
-// DefaultListDocumentType executes a gorm list call
-func DefaultListDocumentType(ctx context.Context, db *gorm.DB) ([]*DocumentType, error) {
-	in := DocumentType{}
+// DefaultListLifecycle executes a gorm list call
+func DefaultListLifecycle(ctx context.Context, db *gorm.DB) ([]*Lifecycle, error) {
+	in := Lifecycle{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	ormResponse := []DocumentTypeORM{}
+	db = db.Order("id")
+	ormResponse := []LifecycleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(DocumentTypeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(LifecycleORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*DocumentType{}
+	pbResponse := []*Lifecycle{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3330,14 +5715,14 @@ func DefaultListDocumentType(ctx context.Context, db *gorm.DB) ([]*DocumentType,
 	return pbResponse, nil
 }
 
-type DocumentTypeORMWithBeforeListApplyQuery interface {
+type LifecycleORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentTypeORMWithBeforeListFind interface {
+type LifecycleORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type DocumentTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]DocumentTypeORM) error
+type LifecycleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LifecycleORM) error
 }
 
 // DefaultCreateNodeList executes a basic gorm create call
@@ -33,10 +33,15 @@ func Example() {
 		&sbom.DocumentTypeORM{},
 		&sbom.EdgeORM{},
 		&sbom.ExternalReferenceORM{},
+		&sbom.LifecycleORM{},
 		&sbom.MetadataORM{},
 		&sbom.NodeListORM{},
 		&sbom.NodeORM{},
+		&sbom.OccurrenceORM{},
 		&sbom.PersonORM{},
+		&sbom.PropertyORM{},
+		&sbom.ReleaseNoteORM{},
+		&sbom.ReleaseNotesORM{},
 		&sbom.ToolORM{},
 	}
 
@@ -49,6 +49,7 @@ func init() {
 	serializers[formats.CDX13JSON] = drivers.NewCDX("1.3", formats.JSON)
 	serializers[formats.CDX14JSON] = drivers.NewCDX("1.4", formats.JSON)
 	serializers[formats.CDX15JSON] = drivers.NewCDX("1.5", formats.JSON)
+	serializers[formats.CDX16JSON] = drivers.NewCDX("1.6", formats.JSON)
 	serializers[formats.SPDX23JSON] = drivers.NewSPDX23()
 	regMtx.Unlock()
 }
@@ -94,6 +95,11 @@ func (w *Writer) WriteStreamWithOptions(bom *sbom.Document, wr io.WriteCloser, o
 		format = w.Options.Format
 	}
 
+	// Without a format set, write the document in the one it was read from
+	if format == "" {
+		format = formats.Format(bom.GetMetadata().GetSourceFormat())
+	}
+
 	serializer, err := GetFormatSerializer(format)
 	if err != nil {
 		return fmt.Errorf("getting serializer: %w", err)
@@ -86,4 +86,5 @@ func testDocument(t *testing.T, golden, sut *sbom.Document) {
 	require.Equal(t, golden.Metadata.Id, sut.Metadata.Id)
 	require.Equal(t, golden.Metadata.DocumentTypes, sut.Metadata.DocumentTypes)
 	require.Equal(t, golden.Metadata.Version, sut.Metadata.Version)
+	require.Equal(t, golden.Metadata.SourceFormat, sut.Metadata.SourceFormat)
 }
@@ -0,0 +1,110 @@
+package conformance
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/bom-squad/protobom/pkg/formats"
+	cdxformats "github.com/bom-squad/protobom/pkg/formats/cyclonedx"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/reader"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/bom-squad/protobom/pkg/writer"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSPDXToCDX converts an SPDX document to CycloneDX and checks the
+// relationships are mapped to the CycloneDX structure.
+func TestSPDXToCDX(t *testing.T) {
+	doc, err := reader.New().ParseFile(filepath.Join("testdata", "crossformat", "app.spdx.json"))
+	require.NoError(t, err)
+
+	// DESCRIBES sets the root, CONTAINS and DEPENDS_ON become edges
+	require.Equal(t, []string{"Package-app"}, doc.NodeList.RootElements)
+	targets := map[sbom.Edge_Type][]string{}
+	for _, e := range doc.NodeList.Edges {
+		if e.From == "Package-app" {
+			targets[e.Type] = append(targets[e.Type], e.To...)
+		}
+	}
+	require.Equal(t, map[sbom.Edge_Type][]string{
+		sbom.Edge_contains:  {"File-main"},
+		sbom.Edge_dependsOn: {"Package-libfoo", "Package-libbar"},
+	}, targets)
+
+	var buf bytes.Buffer
+	require.NoError(t, writer.New().WriteStreamWithOptions(
+		doc, nopWriteCloser{&buf}, &writer.Options{Format: formats.CDX15JSON},
+	))
+
+	bom := &cdx.BOM{}
+	require.NoError(t, cdx.NewBOMDecoder(bytes.NewReader(buf.Bytes()), cdx.BOMFileFormatJSON).Decode(bom))
+	require.Equal(t, cdx.SpecVersion1_5, bom.SpecVersion)
+	require.Empty(t, bom.SerialNumber, "SPDX identifiers are not valid serial numbers")
+
+	// The root is the metadata component, the files it contains are nested
+	root := bom.Metadata.Component
+	require.NotNil(t, root)
+	require.Equal(t, "Package-app", root.BOMRef)
+	require.Equal(t, cdx.ComponentTypeApplication, root.Type)
+	require.NotNil(t, root.Components)
+	require.Len(t, *root.Components, 1)
+	require.Equal(t, "File-main", (*root.Components)[0].BOMRef)
+	require.Equal(t, cdx.ComponentTypeFile, (*root.Components)[0].Type)
+
+	refs := map[string]struct{}{root.BOMRef: {}, "File-main": {}}
+	require.NotNil(t, bom.Components)
+	for _, c := range *bom.Components {
+		require.Equal(t, cdx.ComponentTypeLibrary, c.Type)
+		refs[c.BOMRef] = struct{}{}
+	}
+	require.Len(t, refs, 4)
+
+	// Each component is listed once in the dependencies and all refs resolve
+	require.NotNil(t, bom.Dependencies)
+	deps := map[string][]string{}
+	for _, d := range *bom.Dependencies {
+		require.NotContains(t, deps, d.Ref, "duplicate dependency entry")
+		require.Contains(t, refs, d.Ref)
+		deps[d.Ref] = *d.Dependencies
+		for _, target := range *d.Dependencies {
+			require.Contains(t, refs, target)
+		}
+	}
+	require.Equal(t, map[string][]string{
+		"Package-app":    {"Package-libfoo", "Package-libbar"},
+		"Package-libfoo": {"Package-libbar"},
+	}, deps)
+
+	// The output can be read back
+	parsed, err := reader.New().ParseStream(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, parsed.NodeList.Nodes, len(doc.NodeList.Nodes))
+}
+
+// TestSPDXToCDXSourceSHA256 checks the digest of an SPDX document is
+// recorded in the CycloneDX document converted from it.
+func TestSPDXToCDXSourceSHA256(t *testing.T) {
+	path := filepath.Join("testdata", "crossformat", "app.spdx.json")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	doc, err := reader.New().ParseFile(path)
+	require.NoError(t, err)
+
+	serializer, err := writer.GetFormatSerializer(formats.CDX15JSON)
+	require.NoError(t, err)
+	out, err := serializer.Serialize(doc, &native.SerializeOptions{SourceSHA256: true}, nil)
+	require.NoError(t, err)
+
+	bom := out.(*cdx.BOM)
+	require.NotNil(t, bom.Metadata.Properties)
+	require.Contains(t, *bom.Metadata.Properties, cdx.Property{
+		Name: cdxformats.PropertySourceSHA256, Value: fmt.Sprintf("%x", sha256.Sum256(data)),
+	})
+}
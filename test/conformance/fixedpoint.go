@@ -0,0 +1,46 @@
+package conformance
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bom-squad/protobom/pkg/formats"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/reader"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/bom-squad/protobom/pkg/writer"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertFixedPoint checks that serializing a document to format is
+// idempotent: the document is serialized and rendered, the output is parsed
+// back and serialized again. The test fails if the second rendering differs
+// from the first one.
+func AssertFixedPoint(t testing.TB, format formats.Format, bom *sbom.Document) {
+	t.Helper()
+
+	serializer, err := writer.GetFormatSerializer(format)
+	require.NoError(t, err)
+	unserializer, err := reader.GetFormatUnserializer(format)
+	require.NoError(t, err)
+
+	first := renderDocument(t, serializer, bom)
+
+	parsed, err := unserializer.Unserialize(bytes.NewReader(first), &native.UnserializeOptions{}, nil)
+	require.NoError(t, err, "parsing first rendering")
+
+	second := renderDocument(t, serializer, parsed)
+	require.Equal(t, string(first), string(second), "document is not a fixed point of %s", format)
+}
+
+// renderDocument serializes and renders a document using serializer.
+func renderDocument(t testing.TB, serializer native.Serializer, bom *sbom.Document) []byte {
+	t.Helper()
+
+	nativeDoc, err := serializer.Serialize(bom, &native.SerializeOptions{}, nil)
+	require.NoError(t, err, "serializing document")
+
+	var buf bytes.Buffer
+	require.NoError(t, serializer.Render(nativeDoc, &buf, &native.RenderOptions{Indent: 2}, nil), "rendering document")
+	return buf.Bytes()
+}
@@ -0,0 +1,149 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/bom-squad/protobom/pkg/formats"
+	"github.com/bom-squad/protobom/pkg/native"
+	"github.com/bom-squad/protobom/pkg/reader"
+	"github.com/bom-squad/protobom/pkg/sbom"
+	"github.com/bom-squad/protobom/pkg/writer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedPoint(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		prepare func(*sbom.Document)
+	}{
+		{
+			name: "single root",
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.AddRootNode(&sbom.Node{Id: "root", Name: "root", Version: "1.0.0"})
+			},
+		},
+		{
+			name: "nested components and dependencies",
+			prepare: func(doc *sbom.Document) {
+				doc.NodeList.AddRootNode(&sbom.Node{
+					Id: "root", Name: "root", Version: "1.0.0",
+					PrimaryPurpose: []sbom.Purpose{sbom.Purpose_APPLICATION},
+				})
+				doc.NodeList.AddNode(&sbom.Node{
+					Id: "lib1", Name: "lib1", Version: "2.1.0",
+					PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+					Identifiers: map[int32]string{
+						int32(sbom.SoftwareIdentifierType_PURL): "pkg:golang/example.com/lib1@v2.1.0",
+					},
+				})
+				doc.NodeList.AddNode(&sbom.Node{
+					Id: "lib2", Name: "lib2", Version: "0.3.0",
+					PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+				})
+				doc.NodeList.AddNode(&sbom.Node{
+					Id: "lib3", Name: "lib3", Version: "4.0.0",
+					PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+				})
+				doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "root", To: []string{"lib1", "lib2", "lib3"}})
+				doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_dependsOn, From: "lib1", To: []string{"lib3"}})
+			},
+		},
+		{
+			name: "hashes, licenses and metadata",
+			prepare: func(doc *sbom.Document) {
+				doc.Metadata.Id = "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+				doc.Metadata.Version = "1"
+				doc.Metadata.Tools = []*sbom.Tool{{Name: "generator", Version: "1.0.0"}}
+				doc.Metadata.Authors = []*sbom.Person{{Name: "John Doe", Email: "john@example.com"}}
+				doc.NodeList.AddRootNode(&sbom.Node{
+					Id: "root", Name: "root", Version: "1.0.0",
+					Licenses: []string{"Apache-2.0"},
+					Hashes: map[int32]string{
+						int32(sbom.HashAlgorithm_SHA256): "4f232eeb99e1663d07f0af1af6ea262bf594934b694228e71fd8f159f9a19f32",
+					},
+				})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := sbom.NewDocument()
+			tc.prepare(doc)
+			AssertFixedPoint(t, formats.CDX15JSON, doc)
+		})
+	}
+}
+
+// TestOSPackageVersions checks that distribution package versions, which
+// carry an epoch and a release, are preserved verbatim.
+func TestOSPackageVersions(t *testing.T) {
+	versions := map[string]string{
+		"curl":   "1:7.61.1-34.el8",
+		"bash":   "5.1-6ubuntu1",
+		"libssl": "2:1.1.1k-9.el8_7~beta+1",
+	}
+
+	for _, format := range []formats.Format{formats.CDX15JSON, formats.SPDX23JSON} {
+		t.Run(string(format), func(t *testing.T) {
+			doc := sbom.NewDocument()
+			doc.NodeList.AddRootNode(&sbom.Node{Id: "image", Name: "image", Version: "1.0.0"})
+			for _, name := range []string{"curl", "bash", "libssl"} {
+				doc.NodeList.AddNode(&sbom.Node{
+					Id: name, Name: name, Version: versions[name],
+					PrimaryPurpose: []sbom.Purpose{sbom.Purpose_LIBRARY},
+				})
+				doc.NodeList.AddEdge(&sbom.Edge{Type: sbom.Edge_contains, From: "image", To: []string{name}})
+			}
+
+			serializer, err := writer.GetFormatSerializer(format)
+			require.NoError(t, err)
+			unserializer, err := reader.GetFormatUnserializer(format)
+			require.NoError(t, err)
+
+			parsed, err := unserializer.Unserialize(
+				bytes.NewReader(renderDocument(t, serializer, doc)), &native.UnserializeOptions{}, nil,
+			)
+			require.NoError(t, err)
+
+			found := 0
+			for _, n := range parsed.NodeList.Nodes {
+				if v, ok := versions[n.Name]; ok {
+					require.Equal(t, v, n.Version)
+					found++
+				}
+			}
+			require.Equal(t, len(versions), found)
+		})
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// TestSourceFormatDefault checks that a document is written back in the
+// format it was read from when the writer has no format set.
+func TestSourceFormatDefault(t *testing.T) {
+	doc, err := reader.New().ParseFile(filepath.Join("testdata", "cyclonedx", "1.4", "json", "bom-1.4.json"))
+	require.NoError(t, err)
+	require.Equal(t, string(formats.CDX14JSON), doc.Metadata.SourceFormat)
+
+	specVersion := func(t *testing.T, format formats.Format) string {
+		var buf bytes.Buffer
+		w := writer.New()
+		require.NoError(t, w.WriteStreamWithOptions(doc, nopWriteCloser{&buf}, &writer.Options{Format: format}))
+		rendered := struct {
+			SpecVersion string `json:"specVersion"`
+		}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &rendered))
+		return rendered.SpecVersion
+	}
+
+	require.Equal(t, "1.4", specVersion(t, ""))
+	require.Equal(t, "1.5", specVersion(t, formats.CDX15JSON))
+}